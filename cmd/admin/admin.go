@@ -0,0 +1,132 @@
+/*
+Command line tools for administering the health service.
+
+	admin formats
+	admin import -format daylio -in export.csv [-user 42 -addr localhost:50051 -token $TOKEN]
+
+Without -addr, import only converts the export, printing the parsed logs as newline delimited JSON.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kic/health/pkg/importer"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "formats":
+		for _, format := range importer.Formats() {
+			fmt.Printf("%v\t%v\n", format.Name, format.Description)
+		}
+	case "import":
+		importCommand(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %v formats | import -format <name> -in <file> [-user <id> -addr <host:port> -token <jwt>]\n", os.Args[0])
+	os.Exit(2)
+}
+
+func importCommand(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	formatName := flags.String("format", "", "name of the export format, see the formats command")
+	in := flags.String("in", "", "path of the exported file")
+	userID := flags.Int64("user", 0, "ID of the user to import the data for")
+	addr := flags.String("addr", "", "address of the health service, the export is only converted if unset")
+	token := flags.String("token", os.Getenv("HEALTH_TOKEN"), "bearer token of the user, defaults to $HEALTH_TOKEN")
+	flags.Parse(args)
+
+	if *formatName == "" || *in == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	data, err := ioutil.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("cannot read export: %v", err)
+	}
+
+	if *addr == "" {
+		convert(*formatName, data, *userID)
+		return
+	}
+	if len(data) > importer.MaxExportSize {
+		log.Fatalf("export is %v bytes, the largest that can be imported is %v, split it or convert it without -addr", len(data), importer.MaxExportSize)
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("fail to dial: %v", err)
+	}
+	defer conn.Close()
+	client := pbhealth.NewHealthTrackingClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("Authorization", fmt.Sprintf("Bearer %v", *token)))
+
+	res, err := client.ImportExternalHealthData(ctx, &pbhealth.ImportExternalHealthDataRequest{
+		UserID: *userID,
+		Format: *formatName,
+		Data:   data,
+	})
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+
+	for _, rowError := range res.Errors {
+		log.Printf("row %v not imported (%v): %v", rowError.Row, rowError.Reason, rowError.Message)
+	}
+	log.Printf("imported %v mental health logs for user %v", res.NumImported, *userID)
+}
+
+// convert - print the logs parsed from an export as newline delimited JSON, reporting the rows that cannot be parsed
+func convert(formatName string, data []byte, userID int64) {
+	format, ok := importer.Get(formatName)
+	if !ok {
+		log.Fatalf("unknown import format %q", formatName)
+	}
+
+	records, err := format.Parse(bytes.NewReader(data))
+	if err != nil {
+		log.Fatalf("cannot read %v export: %v", format.Name, err)
+	}
+
+	converted := 0
+	for _, record := range records {
+		if record.Err != nil {
+			log.Print(record.Err)
+			continue
+		}
+		record.HealthLog.UserID = userID
+		line, err := protojson.Marshal(record.HealthLog)
+		if err != nil {
+			log.Fatalf("cannot encode mental health log: %v", err)
+		}
+		fmt.Println(string(line))
+		converted++
+	}
+	log.Printf("converted %v of %v rows", converted, len(records))
+}
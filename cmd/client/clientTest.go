@@ -59,6 +59,10 @@ func main() {
 	shouldGetLogsForDate(authCtx, client, userID)
 	// -----------------------
 
+	// Getting mental health logs for a user within a date range
+	shouldGetLogsInRange(authCtx, client, userID)
+	// -----------------------
+
 	// Getting overall score for user
 	shouldGetScore(authCtx, client, userID)
 	// ----------------------
@@ -130,6 +134,23 @@ func shouldGetLogsForDate(authCtx context.Context, client pbhealth.HealthTrackin
 	log.Printf("Logs retrieved: %v\n", getAllByDateRes.HealthData)
 }
 
+func shouldGetLogsInRange(authCtx context.Context, client pbhealth.HealthTrackingClient, userID int64) {
+	getInRangeReq := &pbhealth.GetHealthDataInRangeRequest{
+		UserID:    userID,
+		StartDate: &pbcommon.Date{Year: 2021, Month: 4, Day: 1},
+		EndDate:   &pbcommon.Date{Year: 2021, Month: 4, Day: 30},
+		SortOrder: pbhealth.SortOrder_DESCENDING,
+		PageSize:  10,
+	}
+
+	getInRangeRes, err := client.GetHealthDataInRange(authCtx, getInRangeReq)
+	if err != nil {
+		log.Fatal("cannot get mental health logs for user in range: ", err)
+	}
+	log.Printf("getInRangeRes: %v\n", getInRangeRes)
+	log.Printf("Logs retrieved: %v\n", getInRangeRes.HealthData)
+}
+
 func shouldGetScore(authCtx context.Context, client pbhealth.HealthTrackingClient, userID int64) {
 	getScoreReq := &pbhealth.GetMentalHealthScoreForUserRequest{UserID: userID}
 	getScoreRes, err := client.GetMentalHealthScoreForUser(authCtx, getScoreReq)
@@ -144,7 +165,7 @@ func shouldGetScore(authCtx context.Context, client pbhealth.HealthTrackingClien
 func shouldDeleteAllLogs(authCtx context.Context, client pbhealth.HealthTrackingClient, userID int64) {
 	deleteReq := &pbhealth.DeleteHealthDataForUserRequest{
 		UserID: userID,
-		Data:   &pbhealth.DeleteHealthDataForUserRequest_All{true},
+		Data:   &pbhealth.DeleteHealthDataForUserRequest_All{All: true},
 	}
 
 	deleteRes, err := client.DeleteHealthDataForUser(authCtx, deleteReq)
@@ -159,7 +180,7 @@ func shouldDeleteAllLogs(authCtx context.Context, client pbhealth.HealthTracking
 func shouldDeleteLogsForDate(authCtx context.Context, client pbhealth.HealthTrackingClient, userID int64) {
 	deleteReq2 := &pbhealth.DeleteHealthDataForUserRequest{
 		UserID: userID,
-		Data:   &pbhealth.DeleteHealthDataForUserRequest_DateToRemove{&pbcommon.Date{
+		Data:   &pbhealth.DeleteHealthDataForUserRequest_DateToRemove{DateToRemove: &pbcommon.Date{
 			Year:  2021,
 			Month: 4,
 			Day:   26,
@@ -24,13 +24,25 @@ func main() {
 
 	repo, mongoClient := setup.DBRepositorySetup(logger, "health")
 
-	serv := setup.GRPCSetup(logger, repo)
+	assessments := setup.AssessmentRepositorySetup(logger, mongoClient, "health")
 
+	surveys := setup.SurveyRepositorySetup(logger, mongoClient, "health", repo)
+
+	auditSink := setup.AuditSetup(logger, mongoClient, "health")
+
+	serv := setup.GRPCSetup(logger, repo, assessments, surveys, auditSink)
+
+	stopPurge := setup.TrashPurgeSetup(logger, repo)
+
+	stopRotation := setup.KeyRotationSetup(logger, repo)
+
+	defer stopRotation()
+	defer stopPurge()
 	defer serv.Stop()
 	defer mongoClient.Disconnect(context.Background())
 
 	// the server is listening in a goroutine so hang until we get an interrupt signal
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 	<-c
 }
\ No newline at end of file
@@ -1,8 +1,9 @@
 module github.com/kic/health
 
-go 1.14
+go 1.15
 
 require (
+	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/golang/protobuf v1.5.2
 	go.mongodb.org/mongo-driver v1.5.1
 	go.uber.org/zap v1.16.0
@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/internal/auth"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// how long recording an audit event may take, however the call it audits ended
+const recordTimeout = 10 * time.Second
+
+// Sink - interface for where audit events are kept, so the audit log can be stored and searched in different backends
+type Sink interface {
+	Record(ctx context.Context, event *pbhealth.AuditEvent) error
+	Query(ctx context.Context, query *Query) ([]*pbhealth.AuditEvent, error)
+}
+
+// Query - filters for searching audit events, zero values matching every event
+type Query struct {
+	ActorID      int64
+	TargetUserID int64
+	Method       string
+	EntryID      string
+	Outcome      string
+	Start        time.Time
+	End          time.Time
+	Limit        int
+}
+
+// matches - whether the event passes every filter of the query
+func (q *Query) matches(event *pbhealth.AuditEvent) bool {
+	if q.ActorID != 0 && event.ActorID != q.ActorID {
+		return false
+	}
+	if q.TargetUserID != 0 && event.TargetUserID != q.TargetUserID {
+		return false
+	}
+	if q.Method != "" && event.Method != q.Method {
+		return false
+	}
+	if q.Outcome != "" && event.Outcome != q.Outcome {
+		return false
+	}
+	if q.EntryID != "" && !containsString(event.EntryIDs, q.EntryID) {
+		return false
+	}
+
+	eventTime := event.Time.AsTime()
+	if !q.Start.IsZero() && eventTime.Before(q.Start) {
+		return false
+	}
+	if !q.End.IsZero() && !eventTime.Before(q.End) {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor - record an audit event for every call to the given services once its handler finishes.
+// It must run after authentication, so the caller is known.
+func UnaryServerInterceptor(sink Sink, logger *zap.SugaredLogger, serviceNames ...string) grpc.UnaryServerInterceptor {
+	prefixes := methodPrefixes(serviceNames)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !audited(prefixes, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+
+		record(sink, logger, NewEvent(ctx, info.FullMethod, req, resp, err))
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor - record an audit event for every streaming call to the given services once its handler
+// finishes. The first message received names the target user, and entry IDs are taken from every message sent or
+// received. It must run after authentication, so the caller is known.
+func StreamServerInterceptor(sink Sink, logger *zap.SugaredLogger, serviceNames ...string) grpc.StreamServerInterceptor {
+	prefixes := methodPrefixes(serviceNames)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !audited(prefixes, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		stream := &auditedStream{ServerStream: ss, entryIDs: make([]string, 0), seen: make(map[string]bool)}
+		err := handler(srv, stream)
+
+		ctx := ss.Context()
+		event := NewEvent(ctx, info.FullMethod, stream.firstReceived, nil, err)
+		event.EntryIDs = stream.entryIDs
+		record(sink, logger, event)
+
+		return err
+	}
+}
+
+// auditedStream - a server stream that remembers the first message received and the entry IDs named by every message
+// passing through it, rather than the messages, so a long stream is audited without being kept in memory
+type auditedStream struct {
+	grpc.ServerStream
+	firstReceived interface{}
+	entryIDs      []string
+	seen          map[string]bool
+}
+
+func (s *auditedStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.addEntryIDs(m)
+	}
+	return err
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if s.firstReceived == nil {
+			s.firstReceived = m
+		}
+		s.addEntryIDs(m)
+	}
+	return err
+}
+
+// addEntryIDs - remember the entry IDs of a message that were not named by an earlier one
+func (s *auditedStream) addEntryIDs(m interface{}) {
+	for _, id := range entryIDs(m) {
+		if !s.seen[id] {
+			s.seen[id] = true
+			s.entryIDs = append(s.entryIDs, id)
+		}
+	}
+}
+
+func methodPrefixes(serviceNames []string) []string {
+	prefixes := make([]string, 0, len(serviceNames))
+	for _, name := range serviceNames {
+		prefixes = append(prefixes, "/"+name+"/")
+	}
+	return prefixes
+}
+
+func audited(prefixes []string, fullMethod string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// record - a failure to audit is logged rather than failing a call that has already happened. The event is recorded
+// on a context of its own, as a client that cancels the call once it has its data must still be audited.
+func record(sink Sink, logger *zap.SugaredLogger, event *pbhealth.AuditEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	if err := sink.Record(ctx, event); err != nil {
+		logger.Errorf("cannot record audit event for %v by user %v: %v", event.Method, event.ActorID, err)
+	}
+}
+
+// NewEvent - the audit event for a finished call to method
+func NewEvent(ctx context.Context, method string, req interface{}, resp interface{}, err error) *pbhealth.AuditEvent {
+	actorID, _ := auth.CallerFromContext(ctx)
+
+	var targetUserID int64
+	if withUser, ok := req.(withUserID); ok {
+		targetUserID = withUser.GetUserID()
+	}
+
+	return &pbhealth.AuditEvent{
+		Time:         timestamppb.Now(),
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		Method:       method,
+		EntryIDs:     entryIDs(req, resp),
+		Outcome:      status.Code(err).String(),
+	}
+}
+
+// getters of the request and response messages that name users and log entries
+type (
+	withUserID interface {
+		GetUserID() int64
+	}
+	withEntryID interface {
+		GetId() string
+	}
+	withEntryIDs interface {
+		GetIds() *pbhealth.HealthLogIDs
+	}
+	withHealthLog interface {
+		GetHealthLog() *pbhealth.MentalHealthLog
+	}
+	withHealthData interface {
+		GetHealthData() []*pbhealth.MentalHealthLog
+	}
+	withRevisions interface {
+		GetRevisions() []*pbhealth.HealthLogRevision
+	}
+)
+
+// entryIDs - the IDs of the log entries named in a request or returned in its response
+func entryIDs(messages ...interface{}) []string {
+	seen := make(map[string]bool)
+	toReturn := make([]string, 0)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			toReturn = append(toReturn, id)
+		}
+	}
+
+	for _, message := range messages {
+		if withID, ok := message.(withEntryID); ok {
+			add(withID.GetId())
+		}
+		if withIDs, ok := message.(withEntryIDs); ok {
+			for _, id := range withIDs.GetIds().GetIds() {
+				add(id)
+			}
+		}
+		if withLog, ok := message.(withHealthLog); ok {
+			add(withLog.GetHealthLog().GetId())
+		}
+		if withLogs, ok := message.(withHealthData); ok {
+			for _, healthLog := range withLogs.GetHealthData() {
+				add(healthLog.GetId())
+			}
+		}
+		if withRevs, ok := message.(withRevisions); ok {
+			for _, revision := range withRevs.GetRevisions() {
+				add(revision.GetLogID())
+			}
+		}
+	}
+
+	return toReturn
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// FileSink - keeps audit events as JSON lines appended to a file
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (f *FileSink) Record(ctx context.Context, event *pbhealth.AuditEvent) error {
+	line, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Query - scan the whole file for matching events, which is fine for the volumes a single file is meant for
+func (f *FileSink) Query(ctx context.Context, query *Query) ([]*pbhealth.AuditEvent, error) {
+	toReturn := make([]*pbhealth.AuditEvent, 0)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.Open(f.path)
+	if os.IsNotExist(err) {
+		return toReturn, nil
+	} else if err != nil {
+		return toReturn, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		event := &pbhealth.AuditEvent{}
+		if err = protojson.Unmarshal(scanner.Bytes(), event); err != nil {
+			return toReturn, err
+		}
+		if query.matches(event) {
+			toReturn = append(toReturn, event)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return toReturn, err
+	}
+
+	sort.SliceStable(toReturn, func(i, j int) bool {
+		return toReturn[i].Time.AsTime().After(toReturn[j].Time.AsTime())
+	})
+	if query.Limit > 0 && len(toReturn) > query.Limit {
+		toReturn = toReturn[:query.Limit]
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// MongoSink - keeps audit events in a mongo collection
+type MongoSink struct {
+	collection *mongo.Collection
+
+	logger *zap.SugaredLogger
+}
+
+func NewMongoSink(collection *mongo.Collection, logger *zap.SugaredLogger) *MongoSink {
+	m := &MongoSink{
+		collection: collection,
+		logger:     logger,
+	}
+	m.createIndexes()
+	return m
+}
+
+// createIndexes - set up the indexes the common audit searches rely on
+func (m *MongoSink) createIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := m.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "targetuserid", Value: 1}, {Key: "time.seconds", Value: -1}}},
+		{Keys: bson.D{{Key: "actorid", Value: 1}, {Key: "time.seconds", Value: -1}}},
+		{Keys: bson.D{{Key: "entryids", Value: 1}}},
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating audit indexes: %v", err)
+	}
+}
+
+func (m *MongoSink) Record(ctx context.Context, event *pbhealth.AuditEvent) error {
+	_, err := m.collection.InsertOne(ctx, event)
+	return err
+}
+
+func (m *MongoSink) Query(ctx context.Context, query *Query) ([]*pbhealth.AuditEvent, error) {
+	toReturn := make([]*pbhealth.AuditEvent, 0)
+
+	filter := bson.M{}
+	if query.ActorID != 0 {
+		filter["actorid"] = query.ActorID
+	}
+	if query.TargetUserID != 0 {
+		filter["targetuserid"] = query.TargetUserID
+	}
+	if query.Method != "" {
+		filter["method"] = query.Method
+	}
+	if query.EntryID != "" {
+		filter["entryids"] = query.EntryID
+	}
+	if query.Outcome != "" {
+		filter["outcome"] = query.Outcome
+	}
+	if !query.Start.IsZero() || !query.End.IsZero() {
+		timeFilter := bson.M{}
+		if !query.Start.IsZero() {
+			timeFilter["$gte"] = query.Start.Unix()
+		}
+		if !query.End.IsZero() {
+			timeFilter["$lt"] = query.End.Unix()
+		}
+		filter["time.seconds"] = timeFilter
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "time.seconds", Value: -1}, {Key: "time.nanos", Value: -1}})
+	if query.Limit > 0 {
+		findOptions.SetLimit(int64(query.Limit))
+	}
+
+	cur, err := m.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding audit events: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		event := &pbhealth.AuditEvent{}
+		if err = cur.Decode(event); err != nil {
+			m.logger.Errorf("Error decoding audit event: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, event)
+	}
+
+	return toReturn, cur.Err()
+}
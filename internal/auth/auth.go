@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+	reflectionPrefix    = "/grpc.reflection."
+)
+
+type callerKey struct{}
+
+// NewContext - a copy of ctx carrying the ID of the user making the call
+func NewContext(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, callerKey{}, userID)
+}
+
+// CallerFromContext - the ID of the user making the call, if the call was authenticated
+func CallerFromContext(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(callerKey{}).(int64)
+	return userID, ok
+}
+
+// Authenticator - validates the JWT bearer tokens handed out by the users service and binds each call to the
+// user the token was issued to
+type Authenticator struct {
+	secret []byte
+
+	logger *zap.SugaredLogger
+}
+
+func NewAuthenticator(secret []byte, logger *zap.SugaredLogger) *Authenticator {
+	return &Authenticator{
+		secret: secret,
+		logger: logger,
+	}
+}
+
+// Authenticate - validate the bearer token in the incoming metadata of ctx, returning a context carrying the
+// token's subject as the caller
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(authorizationHeader)) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "Authorization token is required")
+	}
+
+	header := md.Get(authorizationHeader)[0]
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return nil, status.Errorf(codes.Unauthenticated, "Authorization must be a bearer token")
+	}
+
+	claims := &jwt.StandardClaims{}
+	_, err := jwt.ParseWithClaims(header[len(bearerPrefix):], claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return a.secret, nil
+	})
+	if err != nil {
+		a.logger.Infof("Rejected authorization token: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid authorization token")
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		a.logger.Infof("Rejected authorization token with subject %q", claims.Subject)
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid authorization token")
+	}
+
+	return NewContext(ctx, userID), nil
+}
+
+// UnaryServerInterceptor - authenticate every unary call before it reaches its handler
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authCtx, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authCtx, req)
+	}
+}
+
+// StreamServerInterceptor - authenticate every streaming call before it reaches its handler, leaving server
+// reflection open
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, reflectionPrefix) {
+			return handler(srv, stream)
+		}
+
+		authCtx, err := a.Authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: authCtx})
+	}
+}
+
+// authenticatedStream - a server stream whose context carries the caller
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"github.com/kic/health/internal/audit"
+	"github.com/kic/health/internal/auth"
+	"github.com/kic/health/pkg/database"
+	"github.com/kic/health/pkg/logtime"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/survey"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAuditQueryLimit = 100
+	maxAuditQueryLimit     = 1000
+)
+
+type AdminService struct {
+	pbhealth.UnimplementedHealthAdminServer
+	db database.Repository
+
+	// IDs of the users allowed to call the admin service
+	admins map[int64]bool
+
+	auditSink audit.Sink
+
+	assessments database.AssessmentRepository
+
+	surveys database.SurveyRepository
+
+	logger *zap.SugaredLogger
+}
+
+func NewAdminService(db database.Repository, logger *zap.SugaredLogger) *AdminService {
+	return &AdminService{
+		UnimplementedHealthAdminServer: pbhealth.UnimplementedHealthAdminServer{},
+		logger:                         logger,
+		db:                             db,
+		admins:                         make(map[int64]bool),
+	}
+}
+
+// SetAuditSink - where QueryAuditLog searches for audit events
+func (a *AdminService) SetAuditSink(sink audit.Sink) {
+	a.auditSink = sink
+}
+
+// SetAssessmentRepository - where the assessments removed by ShredUserData are stored
+func (a *AdminService) SetAssessmentRepository(assessments database.AssessmentRepository) {
+	a.assessments = assessments
+}
+
+// SetSurveyRepository - where surveys are published, and the responses removed by ShredUserData are stored
+func (a *AdminService) SetSurveyRepository(surveys database.SurveyRepository) {
+	a.surveys = surveys
+}
+
+// SetAdmins - the users allowed to call the admin service
+func (a *AdminService) SetAdmins(userIDs []int64) {
+	a.admins = make(map[int64]bool)
+	for _, userID := range userIDs {
+		a.admins[userID] = true
+	}
+}
+
+func (a *AdminService) RebuildScoreAggregates(
+	ctx context.Context,
+	req *pbhealth.RebuildScoreAggregatesRequest,
+) (*pbhealth.RebuildScoreAggregatesResponse, error) {
+	if err := a.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	numRebuilt, err := a.db.RebuildScoreAggregates(ctx, req.UserIDs)
+	if err != nil {
+		a.logger.Errorf("cannot rebuild score aggregates: %v", err)
+		return &pbhealth.RebuildScoreAggregatesResponse{
+			UsersRebuilt: numRebuilt,
+		}, status.Errorf(codes.Internal, "Error rebuilding score aggregates")
+	}
+
+	a.logger.Infof("Successfully rebuilt score aggregates for %v users\n", numRebuilt)
+
+	return &pbhealth.RebuildScoreAggregatesResponse{UsersRebuilt: numRebuilt}, nil
+}
+
+func (a *AdminService) MigrateLogTimes(
+	ctx context.Context,
+	req *pbhealth.MigrateLogTimesRequest,
+) (*pbhealth.MigrateLogTimesResponse, error) {
+	if err := a.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	location, err := logtime.LoadZone(req.TimeZone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	numMigrated, err := a.db.MigrateLogTimes(ctx, location)
+	if err != nil {
+		a.logger.Errorf("cannot migrate log times: %v", err)
+		return &pbhealth.MigrateLogTimesResponse{
+			EntriesMigrated: numMigrated,
+		}, status.Errorf(codes.Internal, "Error migrating log times")
+	}
+
+	a.logger.Infof("Successfully migrated %v mental health logs to %v\n", numMigrated, location)
+
+	return &pbhealth.MigrateLogTimesResponse{EntriesMigrated: numMigrated}, nil
+}
+
+func (a *AdminService) ShredUserData(
+	ctx context.Context,
+	req *pbhealth.ShredUserDataRequest,
+) (*pbhealth.ShredUserDataResponse, error) {
+	if err := a.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	numDeleted, err := a.db.DeleteUserData(ctx, req.UserID)
+	if err != nil {
+		a.logger.Errorf("cannot shred data for user %v: %v", req.UserID, err)
+		return &pbhealth.ShredUserDataResponse{
+			EntriesDeleted: numDeleted,
+		}, status.Errorf(codes.Internal, "Error shredding user data")
+	}
+
+	var numAssessments uint32
+	if a.assessments != nil {
+		numAssessments, err = a.assessments.DeleteUserAssessments(ctx, req.UserID)
+		if err != nil {
+			a.logger.Errorf("cannot shred assessments for user %v: %v", req.UserID, err)
+			return &pbhealth.ShredUserDataResponse{
+				EntriesDeleted: numDeleted,
+			}, status.Errorf(codes.Internal, "Error shredding user data")
+		}
+	}
+
+	var numSurveyResponses uint32
+	if a.surveys != nil {
+		numSurveyResponses, err = a.surveys.DeleteUserSurveyResponses(ctx, req.UserID)
+		if err != nil {
+			a.logger.Errorf("cannot shred survey responses for user %v: %v", req.UserID, err)
+			return &pbhealth.ShredUserDataResponse{
+				EntriesDeleted:     numDeleted,
+				AssessmentsDeleted: numAssessments,
+			}, status.Errorf(codes.Internal, "Error shredding user data")
+		}
+	}
+
+	a.logger.Infof("Successfully shredded data for user %v, removing %v mental health logs, %v assessments and %v survey responses\n", req.UserID, numDeleted, numAssessments, numSurveyResponses)
+
+	return &pbhealth.ShredUserDataResponse{
+		EntriesDeleted:         numDeleted,
+		AssessmentsDeleted:     numAssessments,
+		SurveyResponsesDeleted: numSurveyResponses,
+	}, nil
+}
+
+func (a *AdminService) QueryAuditLog(
+	ctx context.Context,
+	req *pbhealth.QueryAuditLogRequest,
+) (*pbhealth.QueryAuditLogResponse, error) {
+	if err := a.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if a.auditSink == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Audit log is not configured")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAuditQueryLimit
+	} else if limit > maxAuditQueryLimit {
+		limit = maxAuditQueryLimit
+	}
+
+	query := &audit.Query{
+		ActorID:      req.ActorID,
+		TargetUserID: req.TargetUserID,
+		Method:       req.Method,
+		EntryID:      req.EntryID,
+		Outcome:      req.Outcome,
+		Limit:        limit,
+	}
+	if req.StartTime != nil {
+		query.Start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		query.End = req.EndTime.AsTime()
+	}
+
+	events, err := a.auditSink.Query(ctx, query)
+	if err != nil {
+		a.logger.Errorf("cannot query audit log: %v", err)
+		return nil, status.Errorf(codes.Internal, "Error querying audit log")
+	}
+
+	a.logger.Infof("Successfully found %v audit events\n", len(events))
+
+	return &pbhealth.QueryAuditLogResponse{Events: events}, nil
+}
+
+// authorizeAdmin - make sure the authenticated caller is an administrator
+func (a *AdminService) authorizeAdmin(ctx context.Context) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Caller is not authenticated")
+	}
+	if !a.admins[caller] {
+		return status.Errorf(codes.PermissionDenied, "Caller is not an administrator")
+	}
+	return nil
+}
+
+func (a *AdminService) PublishSurvey(
+	ctx context.Context,
+	req *pbhealth.PublishSurveyRequest,
+) (*pbhealth.PublishSurveyResponse, error) {
+	if err := a.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if a.surveys == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Surveys are not configured")
+	}
+	if req.Survey == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "survey is required")
+	}
+
+	if err := survey.Validate(req.Survey); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid survey: %v", err)
+	}
+
+	caller, _ := auth.CallerFromContext(ctx)
+	published := proto.Clone(req.Survey).(*pbhealth.Survey)
+	published.PublishedBy = caller
+	published.PublishedAt = timestamppb.Now()
+
+	if _, err := a.surveys.PublishSurvey(ctx, published); err != nil {
+		a.logger.Errorf("cannot publish survey %v: %v", published.Id, err)
+		return nil, repositoryError(err, "Error publishing survey")
+	}
+
+	a.logger.Infof("Successfully published version %v of survey %v\n", published.Version, published.Id)
+
+	return &pbhealth.PublishSurveyResponse{Survey: published}, nil
+}
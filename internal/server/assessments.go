@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/pkg/assessment"
+	"github.com/kic/health/pkg/database"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+func (h *HealthService) SubmitAssessment(
+	ctx context.Context,
+	req *pbhealth.SubmitAssessmentRequest,
+) (*pbhealth.SubmitAssessmentResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if h.assessments == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Assessments are not configured")
+	}
+
+	questionnaire, ok := assessment.Get(req.Type)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown assessment type %v", req.Type)
+	}
+	if req.AssessmentDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "assessmentDate is required")
+	}
+
+	newAssessment := &pbhealth.Assessment{
+		UserID:         req.UserID,
+		Type:           req.Type,
+		AssessmentDate: req.AssessmentDate,
+		SubmittedAt:    timestamppb.Now(),
+		Responses:      req.Responses,
+	}
+	if err := questionnaire.Score(newAssessment); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if _, err := h.assessments.AddAssessment(ctx, newAssessment); err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error adding assessment to database")
+	}
+
+	h.logger.Infof("Successfully added %v assessment %v of user %v\n", questionnaire.Name, newAssessment.Id, req.UserID)
+
+	return &pbhealth.SubmitAssessmentResponse{Assessment: newAssessment}, nil
+}
+
+func (h *HealthService) ListAssessments(
+	ctx context.Context,
+	req *pbhealth.ListAssessmentsRequest,
+) (*pbhealth.ListAssessmentsResponse, error) {
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_ONLY); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if h.assessments == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Assessments are not configured")
+	}
+
+	assessments, err := h.assessments.GetAssessments(ctx, req.UserID, &database.AssessmentQuery{
+		Types:     req.Types,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting assessments")
+	}
+
+	return &pbhealth.ListAssessmentsResponse{Assessments: assessments}, nil
+}
+
+func (h *HealthService) GetAssessmentTrend(
+	ctx context.Context,
+	req *pbhealth.GetAssessmentTrendRequest,
+) (*pbhealth.GetAssessmentTrendResponse, error) {
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_ONLY); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if h.assessments == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Assessments are not configured")
+	}
+
+	questionnaire, ok := assessment.Get(req.Type)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown assessment type %v", req.Type)
+	}
+
+	assessments, err := h.assessments.GetAssessments(ctx, req.UserID, &database.AssessmentQuery{
+		Types:     []pbhealth.AssessmentType{req.Type},
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting assessments")
+	}
+
+	res := &pbhealth.GetAssessmentTrendResponse{Points: make([]*pbhealth.AssessmentTrendPoint, 0, len(assessments))}
+	for _, a := range assessments {
+		res.Points = append(res.Points, &pbhealth.AssessmentTrendPoint{
+			AssessmentDate: a.AssessmentDate,
+			TotalScore:     a.TotalScore,
+			Severity:       a.Severity,
+		})
+	}
+	if len(assessments) > 1 {
+		res.Change = assessments[len(assessments)-1].TotalScore - assessments[0].TotalScore
+		res.ReliableChange = questionnaire.ReliableChange(res.Change)
+	}
+
+	return res, nil
+}
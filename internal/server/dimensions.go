@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kic/health/internal/auth"
+	"github.com/kic/health/pkg/dimension"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+func (h *HealthService) ListMoodDimensions(
+	ctx context.Context,
+	req *pbhealth.ListMoodDimensionsRequest,
+) (*pbhealth.ListMoodDimensionsResponse, error) {
+	if _, ok := auth.CallerFromContext(ctx); !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authenticated")
+	}
+
+	return &pbhealth.ListMoodDimensionsResponse{Dimensions: dimension.All()}, nil
+}
+
+// validateDimension - reject a request naming an unknown dimension, an empty name meaning the overall score
+func validateDimension(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, ok := dimension.Get(name); !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown mood dimension %q", name)
+	}
+	return nil
+}
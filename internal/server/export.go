@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/pkg/database"
+	"github.com/kic/health/pkg/export"
+	pbcommon "github.com/kic/health/pkg/proto/common"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// number of records read from the repository and sent per chunk
+const exportChunkSize = 100
+
+func (h *HealthService) ExportUserData(
+	req *pbhealth.ExportUserDataRequest,
+	stream pbhealth.HealthTracking_ExportUserDataServer,
+) error {
+	ctx := stream.Context()
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return err
+	}
+
+	if _, ok := pbhealth.ExportFormat_name[int32(req.Format)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown export format %v", req.Format)
+	}
+
+	err := stream.Send(&pbhealth.ExportUserDataResponse{
+		Data: &pbhealth.ExportUserDataResponse_Manifest{Manifest: &pbhealth.ExportManifest{
+			UserID:      req.UserID,
+			Format:      req.Format,
+			ExportedAt:  timestamppb.Now(),
+			RecordTypes: export.RecordTypes(),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	exporter := &userDataExporter{stream: stream, format: req.Format}
+
+	if err = h.exportLogs(ctx, exporter, req.UserID, false); err != nil {
+		return err
+	}
+	if err = h.exportLogs(ctx, exporter, req.UserID, true); err != nil {
+		return err
+	}
+
+	given, err := h.db.GetAccessGrantsByOwner(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return status.Errorf(codes.Internal, "Error exporting health data")
+	}
+	received, err := h.db.GetAccessGrantsByGrantee(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return status.Errorf(codes.Internal, "Error exporting health data")
+	}
+	records := make([]proto.Message, 0, len(given)+len(received))
+	for _, grant := range append(given, received...) {
+		records = append(records, grant)
+	}
+	if err = exporter.send(export.AccessGrants, records); err != nil {
+		return err
+	}
+
+	records = make([]proto.Message, 0)
+	if h.assessments != nil {
+		assessments, err := h.assessments.GetAssessments(ctx, req.UserID, &database.AssessmentQuery{})
+		if err != nil {
+			h.logger.Errorf("%v", err)
+			return repositoryError(err, "Error exporting health data")
+		}
+		for _, assessment := range assessments {
+			records = append(records, assessment)
+		}
+	}
+	if err = exporter.send(export.Assessments, records); err != nil {
+		return err
+	}
+
+	records = make([]proto.Message, 0)
+	if h.surveys != nil {
+		responses, err := h.surveys.GetSurveyResponses(ctx, req.UserID, "")
+		if err != nil {
+			h.logger.Errorf("%v", err)
+			return repositoryError(err, "Error exporting health data")
+		}
+		for _, response := range responses {
+			records = append(records, response)
+		}
+	}
+	if err = exporter.send(export.SurveyResponses, records); err != nil {
+		return err
+	}
+
+	h.logger.Infof("Successfully exported health data of user %v\n", req.UserID)
+
+	return nil
+}
+
+// exportLogs - send the live logs of a user, or those in the trash, a page at a time, each page followed by the
+// revisions of its logs so neither the whole history nor every revision is ever held at once
+func (h *HealthService) exportLogs(ctx context.Context, exporter *userDataExporter, userID int64, deleted bool) error {
+	recordType := export.MentalHealthLogs
+	if deleted {
+		recordType = export.DeletedMentalHealthLogs
+	}
+
+	query := &database.LogRangeQuery{
+		StartDate: &pbcommon.Date{},
+		EndDate:   &pbcommon.Date{Year: math.MaxInt32, Month: math.MaxInt32, Day: math.MaxInt32},
+		Deleted:   deleted,
+		PageSize:  exportChunkSize,
+	}
+	for {
+		logs, nextPageToken, err := h.db.GetMentalHealthLogsInRange(ctx, userID, query)
+		if err != nil {
+			h.logger.Errorf("%v", err)
+			return repositoryError(err, "Error exporting health data")
+		}
+
+		records := make([]proto.Message, 0, len(logs))
+		ids := make([]string, 0, len(logs))
+		for _, healthLog := range logs {
+			records = append(records, healthLog)
+			ids = append(ids, healthLog.Id)
+		}
+		if err = exporter.send(recordType, records); err != nil {
+			return err
+		}
+
+		revisions, err := h.db.GetRevisionsOfMentalHealthLogs(ctx, userID, ids)
+		if err != nil {
+			h.logger.Errorf("%v", err)
+			return repositoryError(err, "Error exporting health data")
+		}
+		records = make([]proto.Message, 0, len(revisions))
+		for _, revision := range revisions {
+			records = append(records, revision)
+		}
+		if err = exporter.send(export.HealthLogRevisions, records); err != nil {
+			return err
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+		query.PageToken = nextPageToken
+	}
+}
+
+// userDataExporter - sends the records of an export as chunks, keeping an encoder per record type so CSV headers
+// are only written once
+type userDataExporter struct {
+	stream   pbhealth.HealthTracking_ExportUserDataServer
+	format   pbhealth.ExportFormat
+	encoders map[string]*export.Encoder
+}
+
+// send - send records of the given type, split into chunks of at most exportChunkSize. A record type without
+// records still gets a chunk, so CSV exports always carry its header.
+func (e *userDataExporter) send(recordType string, records []proto.Message) error {
+	if e.encoders == nil {
+		e.encoders = make(map[string]*export.Encoder)
+	}
+
+	encoder, ok := e.encoders[recordType]
+	if !ok {
+		var err error
+		if encoder, err = export.NewEncoder(e.format, recordType); err != nil {
+			return status.Errorf(codes.Internal, "Error exporting health data")
+		}
+		e.encoders[recordType] = encoder
+	} else if len(records) == 0 {
+		return nil
+	}
+
+	for start := 0; start == 0 || start < len(records); start += exportChunkSize {
+		end := start + exportChunkSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		data, err := encoder.Encode(records[start:end])
+		if err != nil {
+			return status.Errorf(codes.Internal, "Error exporting health data")
+		}
+
+		err = e.stream.Send(&pbhealth.ExportUserDataResponse{
+			Data: &pbhealth.ExportUserDataResponse_Chunk{Chunk: &pbhealth.ExportChunk{
+				RecordType: recordType,
+				Data:       data,
+				NumRecords: uint32(end - start),
+			}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/fhir"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+func (h *HealthService) GetHealthDataAsFHIR(
+	ctx context.Context,
+	req *pbhealth.GetHealthDataAsFHIRRequest,
+) (*pbhealth.GetHealthDataAsFHIRResponse, error) {
+	scope := pbhealth.GrantScope_SCORES_ONLY
+	if req.IncludeJournals {
+		scope = pbhealth.GrantScope_SCORES_AND_JOURNALS
+	}
+	if err := h.authorizeAccess(ctx, req.UserID, scope); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+
+	if (req.StartDate == nil) != (req.EndDate == nil) {
+		return nil, status.Errorf(codes.InvalidArgument, "startDate and endDate must be set together")
+	}
+
+	var logs []*pbhealth.MentalHealthLog
+	var err error
+	if req.StartDate == nil {
+		logs, err = h.db.GetAllMentalHealthLogs(ctx, req.UserID)
+	} else {
+		logs, err = h.db.GetAllMentalHealthLogsInRange(ctx, req.UserID, req.StartDate, req.EndDate)
+	}
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting health data")
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		if c := date.Compare(logs[i].LogDate, logs[j].LogDate); c != 0 {
+			return c < 0
+		}
+		return logs[i].Id < logs[j].Id
+	})
+
+	observations := make([]*fhir.Observation, 0, len(logs)+1)
+	for _, healthLog := range logs {
+		observations = append(observations, fhir.NewObservation(healthLog, req.IncludeJournals))
+	}
+	if mean := fhir.NewMeanScoreObservation(req.UserID, logs); mean != nil {
+		observations = append(observations, mean)
+	}
+
+	bundle, err := json.Marshal(fhir.NewBundle(observations...))
+	if err != nil {
+		h.logger.Errorf("cannot encode FHIR bundle: %v", err)
+		return nil, status.Errorf(codes.Internal, "Error converting health data")
+	}
+
+	h.logger.Infof("Successfully converted %v mental health logs of user %v to FHIR\n", len(logs), req.UserID)
+
+	return &pbhealth.GetHealthDataAsFHIRResponse{Bundle: string(bundle)}, nil
+}
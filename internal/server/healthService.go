@@ -2,17 +2,42 @@ package server
 
 import (
 	"context"
+	"github.com/kic/health/internal/auth"
+	"github.com/kic/health/pkg/analytics"
 	"github.com/kic/health/pkg/database"
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/dimension"
+	"github.com/kic/health/pkg/logging"
+	"github.com/kic/health/pkg/logtime"
 	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/score"
+	"github.com/kic/health/pkg/tag"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+
+	// longest range, in days, that GetMoodTrends computes statistics over
+	maxTrendRangeDays = 3 * 366
 )
 
 type HealthService struct {
 	pbhealth.UnimplementedHealthTrackingServer
 	db         database.Repository
 
+	idempotencyWindow time.Duration
+
+	assessments database.AssessmentRepository
+
+	surveys database.SurveyRepository
+
 	logger  *zap.SugaredLogger
 }
 
@@ -21,25 +46,72 @@ func NewHealthService(db database.Repository, logger *zap.SugaredLogger) *Health
 		UnimplementedHealthTrackingServer: pbhealth.UnimplementedHealthTrackingServer{},
 		logger:  logger,
 		db: db,
+		idempotencyWindow: defaultIdempotencyWindow,
 	}
 }
 
+// SetAssessmentRepository - where PHQ-9 and GAD-7 assessments are stored
+func (h *HealthService) SetAssessmentRepository(assessments database.AssessmentRepository) {
+	h.assessments = assessments
+}
+
+// SetSurveyRepository - where survey definitions and responses are stored
+func (h *HealthService) SetSurveyRepository(surveys database.SurveyRepository) {
+	h.surveys = surveys
+}
+
+// SetIdempotencyWindow - how long the response to a request with an idempotency key is replayed to retries
+func (h *HealthService) SetIdempotencyWindow(window time.Duration) {
+	h.idempotencyWindow = window
+}
+
 func (h *HealthService) AddHealthDataForUser(
 	ctx context.Context,
 	req *pbhealth.AddHealthDataForUserRequest,
 ) (*pbhealth.AddHealthDataForUserResponse, error) {
-
-	id, err := h.db.AddMentalHealthLog(ctx, req.NewEntry)
-	if err != nil {
-		h.logger.Infof("%v", err)
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if req.NewEntry == nil {
 		return &pbhealth.AddHealthDataForUserResponse{
 			Success: false,
-		}, status.Errorf(codes.Internal, "Error adding mental health log to database")
+		}, status.Errorf(codes.InvalidArgument, "New entry is required")
+	}
+	if req.NewEntry.UserID != req.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "Cannot add health data for another user")
+	}
+	if err := validateLogEntry(req.NewEntry); err != nil {
+		return nil, err
+	}
+	newEntry, err := normalizeLogTime(req.NewEntry)
+	if err != nil {
+		return nil, err
 	}
 
-	h.logger.Infof("Successfully added new mental health log. ID of user: %v\n", id)
+	successRes := &pbhealth.AddHealthDataForUserResponse{}
+	key := requestIdempotencyKey(ctx, req.IdempotencyKey)
+
+	err = h.withIdempotency(ctx, req.UserID, key, "AddHealthDataForUser", req, successRes, func() error {
+		id, err := h.db.AddMentalHealthLog(ctx, newEntry)
+		if err != nil {
+			h.logger.Infof("%v", err)
+			return status.Errorf(codes.Internal, "Error adding mental health log to database")
+		}
 
-	successRes := &pbhealth.AddHealthDataForUserResponse{Success: true}
+		h.logger.Infof("Successfully added new mental health log. ID of user: %v\n", id)
+
+		successRes.Success = true
+		successRes.Id = id
+		return nil
+	})
+	if err != nil {
+		return &pbhealth.AddHealthDataForUserResponse{
+			Success: false,
+		}, err
+	}
 
 	return successRes, err
 }
@@ -48,6 +120,10 @@ func (h *HealthService) GetHealthDataForUser(
 	ctx context.Context,
 	req *pbhealth.GetHealthDataForUserRequest,
 ) (*pbhealth.GetHealthDataForUserResponse, error) {
+	scope, err := h.grantedScope(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
 
 	logs, err := h.db.GetAllMentalHealthLogs(ctx, req.UserID)
 
@@ -58,7 +134,11 @@ func (h *HealthService) GetHealthDataForUser(
 		}, status.Errorf(codes.Internal, "Error getting health data by date")
 	}
 
-	h.logger.Infof("Successfully got mental health log by date: %v\n", logs)
+	h.logger.Infof("Successfully got mental health log by date: %v\n", logging.Redact(logs))
+
+	if scope < pbhealth.GrantScope_SCORES_AND_JOURNALS {
+		logs = withoutJournals(logs)
+	}
 
 	successRes := &pbhealth.GetHealthDataForUserResponse{HealthData: logs}
 
@@ -70,6 +150,12 @@ func (h *HealthService) GetHealthDataByDate(
 	ctx context.Context,
 	req *pbhealth.GetHealthDataByDateRequest,
 ) (*pbhealth.GetHealthDataByDateResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
 	logs, err := h.db.GetAllMentalHealthLogsByDate(ctx, req.UserID, req.LogDate)
 
 	if err != nil {
@@ -79,7 +165,7 @@ func (h *HealthService) GetHealthDataByDate(
 		}, status.Errorf(codes.Internal, "Error getting health data by date")
 	}
 
-	h.logger.Infof("Successfully got mental health log by date: %v\n", logs)
+	h.logger.Infof("Successfully got mental health log by date: %v\n", logging.Redact(logs))
 
 	successRes := &pbhealth.GetHealthDataByDateResponse{HealthData: logs}
 
@@ -87,17 +173,107 @@ func (h *HealthService) GetHealthDataByDate(
 	return successRes, err
 }
 
+func (h *HealthService) GetHealthDataInRange(
+	ctx context.Context,
+	req *pbhealth.GetHealthDataInRangeRequest,
+) (*pbhealth.GetHealthDataInRangeResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Start and end dates are required")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	logs, nextPageToken, err := h.db.GetMentalHealthLogsInRange(ctx, req.UserID, &database.LogRangeQuery{
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Descending: req.SortOrder == pbhealth.SortOrder_DESCENDING,
+		PageSize:   pageSize,
+		PageToken:  req.PageToken,
+	})
+
+	if err != nil {
+		h.logger.Infof("%v", err)
+		return &pbhealth.GetHealthDataInRangeResponse{
+			HealthData: nil,
+		}, repositoryError(err, "Error getting health data in range")
+	}
+
+	h.logger.Infof("Successfully got %v mental health logs in range\n", len(logs))
+
+	successRes := &pbhealth.GetHealthDataInRangeResponse{HealthData: logs, NextPageToken: nextPageToken}
+
+	return successRes, err
+}
+
+func (h *HealthService) GetMoodTrends(
+	ctx context.Context,
+	req *pbhealth.GetMoodTrendsRequest,
+) (*pbhealth.GetMoodTrendsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Start and end dates are required")
+	}
+	if err := validateDimension(req.Dimension); err != nil {
+		return nil, err
+	}
+
+	days := analytics.RangeDays(req.StartDate, req.EndDate)
+	if days <= 0 || days > maxTrendRangeDays {
+		return nil, status.Errorf(codes.InvalidArgument, "Date range must span 1 to %v days", maxTrendRangeDays)
+	}
+
+	logs, err := h.db.GetAllMentalHealthLogsInRange(ctx, req.UserID, analytics.LookbackStart(req.StartDate), req.EndDate)
+	if err != nil {
+		h.logger.Infof("%v", err)
+		return nil, status.Errorf(codes.Internal, "Error getting health data for mood trends")
+	}
+
+	h.logger.Infof("Computing mood trends over %v mental health logs\n", len(logs))
+
+	return analytics.MoodTrends(logs, req.StartDate, req.EndDate, req.Dimension), nil
+}
+
 func (h *HealthService) GetMentalHealthScoreForUser(
 	ctx context.Context,
 	req *pbhealth.GetMentalHealthScoreForUserRequest,
 ) (*pbhealth.GetMentalHealthScoreForUserResponse, error) {
-	score, err := h.db.GetOverallScore(ctx, req.UserID)
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_ONLY); err != nil {
+		return nil, err
+	}
+	if err := validateDimension(req.Dimension); err != nil {
+		return nil, err
+	}
+
+	var score int32
+	var rated bool
+	var err error
+	if req.Dimension != "" {
+		score, rated, err = h.db.GetDimensionScore(ctx, req.UserID, req.Dimension)
+	} else {
+		score, err = h.db.GetOverallScore(ctx, req.UserID)
+	}
 
 	if err != nil {
 		h.logger.Errorf("cannot get mental overall score for user: %v \n", err)
 	}
 
-	res := &pbhealth.GetMentalHealthScoreForUserResponse{Score: score}
+	res := &pbhealth.GetMentalHealthScoreForUserResponse{Score: score, Rated: rated}
 
 	return res, err
 }
@@ -106,6 +282,12 @@ func (h *HealthService) DeleteHealthDataForUser(
 	ctx context.Context,
 	req *pbhealth.DeleteHealthDataForUserRequest,
 ) (*pbhealth.DeleteHealthDataForUserResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
 	var err error
 	var numDeleted uint32
 
@@ -127,13 +309,31 @@ func (h *HealthService) UpdateHealthDataForDate(
 	ctx context.Context,
 	req *pbhealth.UpdateHealthDataForDateRequest,
 ) (*pbhealth.UpdateHealthDataForDateResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return &pbhealth.UpdateHealthDataForDateResponse{
+			Success: false,
+		}, err
+	}
+	if req.DesiredLogInfo == nil {
+		return &pbhealth.UpdateHealthDataForDateResponse{
+			Success: false,
+		}, status.Errorf(codes.InvalidArgument, "Desired log info is required")
+	}
+	if err := validateLogEntry(req.DesiredLogInfo); err != nil {
+		return &pbhealth.UpdateHealthDataForDateResponse{
+			Success: false,
+		}, err
+	}
 
-	err := h.db.UpdateMentalHealthLogs(ctx, req.UserID, req.DesiredLogInfo)
+	err := h.db.UpdateMentalHealthLogs(ctx, req.UserID, req.DesiredLogInfo, req.UpdateMask.GetPaths(), req.ExpectedVersion)
 	if err != nil {
 		h.logger.Errorf("%v", err)
 		return &pbhealth.UpdateHealthDataForDateResponse{
 			Success: false,
-		}, status.Errorf(codes.InvalidArgument, "Error updating mental health logs")
+		}, repositoryError(err, "Error updating mental health logs")
 	}
 
 	h.logger.Infof("Successfully updated mental health log.")
@@ -141,8 +341,416 @@ func (h *HealthService) UpdateHealthDataForDate(
 	successRes := &pbhealth.UpdateHealthDataForDateResponse{Success: true}
 
 	return successRes, err
+}
+
+func (h *HealthService) GetHealthLogByID(
+	ctx context.Context,
+	req *pbhealth.GetHealthLogByIDRequest,
+) (*pbhealth.GetHealthLogByIDResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	healthLog, err := h.db.GetMentalHealthLogByID(ctx, req.UserID, req.Id)
+	if err != nil {
+		h.logger.Infof("%v", err)
+		return nil, repositoryError(err, "Error getting health log by ID")
+	}
+
+	h.logger.Infof("Successfully got mental health log with ID %v\n", req.Id)
+
+	return &pbhealth.GetHealthLogByIDResponse{HealthLog: healthLog}, nil
+}
+
+func (h *HealthService) UpdateHealthLogByID(
+	ctx context.Context,
+	req *pbhealth.UpdateHealthLogByIDRequest,
+) (*pbhealth.UpdateHealthLogByIDResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return &pbhealth.UpdateHealthLogByIDResponse{
+			Success: false,
+		}, err
+	}
+	if req.DesiredLogInfo == nil {
+		return &pbhealth.UpdateHealthLogByIDResponse{
+			Success: false,
+		}, status.Errorf(codes.InvalidArgument, "Desired log info is required")
+	}
+	if err := validateLogEntry(req.DesiredLogInfo); err != nil {
+		return &pbhealth.UpdateHealthLogByIDResponse{
+			Success: false,
+		}, err
+	}
+
+	desiredLogInfo, paths := req.DesiredLogInfo, req.UpdateMask.GetPaths()
+	if len(paths) == 0 || hasLogTimePath(paths) {
+		desiredLogInfo = proto.Clone(req.DesiredLogInfo).(*pbhealth.MentalHealthLog)
+
+		// moving a log in time without naming a time zone keeps the zone it was logged in
+		if len(paths) > 0 && !hasPath(paths, database.TimeZonePath) {
+			stored, err := h.db.GetMentalHealthLogByID(ctx, req.UserID, req.Id)
+			if err != nil {
+				h.logger.Errorf("%v", err)
+				return &pbhealth.UpdateHealthLogByIDResponse{
+					Success: false,
+				}, repositoryError(err, "Error updating mental health log")
+			}
+			desiredLogInfo.TimeZone = stored.TimeZone
+		}
+
+		var err error
+		if desiredLogInfo, err = normalizeLogTime(desiredLogInfo); err != nil {
+			return &pbhealth.UpdateHealthLogByIDResponse{
+				Success: false,
+			}, err
+		}
+		paths = withLogTimePaths(paths)
+	}
+
+	version, err := h.db.UpdateMentalHealthLogByID(ctx, req.UserID, req.Id, desiredLogInfo, paths, req.ExpectedVersion)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return &pbhealth.UpdateHealthLogByIDResponse{
+			Success: false,
+		}, repositoryError(err, "Error updating mental health log")
+	}
+
+	h.logger.Infof("Successfully updated mental health log with ID %v\n", req.Id)
+
+	return &pbhealth.UpdateHealthLogByIDResponse{Success: true, Version: version}, nil
+}
+
+func (h *HealthService) DeleteHealthLogByID(
+	ctx context.Context,
+	req *pbhealth.DeleteHealthLogByIDRequest,
+) (*pbhealth.DeleteHealthLogByIDResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	err := h.db.DeleteMentalHealthLogByID(ctx, req.UserID, req.Id, req.ExpectedVersion)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return &pbhealth.DeleteHealthLogByIDResponse{
+			Success: false,
+		}, repositoryError(err, "Error deleting mental health log")
+	}
+
+	h.logger.Infof("Successfully deleted mental health log with ID %v\n", req.Id)
+
+	return &pbhealth.DeleteHealthLogByIDResponse{Success: true}, nil
+}
+
+func (h *HealthService) ListDeletedHealthLogs(
+	ctx context.Context,
+	req *pbhealth.ListDeletedHealthLogsRequest,
+) (*pbhealth.ListDeletedHealthLogsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	logs, err := h.db.GetDeletedMentalHealthLogs(ctx, req.UserID)
+	if err != nil {
+		h.logger.Infof("%v", err)
+		return &pbhealth.ListDeletedHealthLogsResponse{
+			HealthData: nil,
+		}, repositoryError(err, "Error getting deleted health data")
+	}
+
+	h.logger.Infof("Successfully got %v deleted mental health logs\n", len(logs))
+
+	return &pbhealth.ListDeletedHealthLogsResponse{HealthData: logs}, nil
+}
+
+func (h *HealthService) RestoreHealthLogs(
+	ctx context.Context,
+	req *pbhealth.RestoreHealthLogsRequest,
+) (*pbhealth.RestoreHealthLogsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	var err error
+	var numRestored uint32
+
+	switch x := req.Data.(type) {
+	case *pbhealth.RestoreHealthLogsRequest_All:
+		numRestored, err = h.db.RestoreMentalHealthLogs(ctx, req.UserID, nil, x.All)
+	case *pbhealth.RestoreHealthLogsRequest_Ids:
+		numRestored, err = h.db.RestoreMentalHealthLogs(ctx, req.UserID, x.Ids.GetIds(), false)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Either all or ids is required")
+	}
+
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return &pbhealth.RestoreHealthLogsResponse{
+			EntriesRestored: numRestored,
+		}, repositoryError(err, "Error restoring mental health logs")
+	}
+
+	h.logger.Infof("Successfully restored %v mental health logs\n", numRestored)
+
+	return &pbhealth.RestoreHealthLogsResponse{EntriesRestored: numRestored}, nil
+}
+
+func (h *HealthService) GetHealthLogRevisions(
+	ctx context.Context,
+	req *pbhealth.GetHealthLogRevisionsRequest,
+) (*pbhealth.GetHealthLogRevisionsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	revisions, err := h.db.GetMentalHealthLogRevisions(ctx, req.UserID, req.Id)
+	if err != nil {
+		h.logger.Infof("%v", err)
+		return nil, repositoryError(err, "Error getting health log revisions")
+	}
+
+	h.logger.Infof("Successfully got %v revisions of mental health log with ID %v\n", len(revisions), req.Id)
+
+	return &pbhealth.GetHealthLogRevisionsResponse{Revisions: revisions}, nil
+}
+
+func (h *HealthService) RevertHealthLogToRevision(
+	ctx context.Context,
+	req *pbhealth.RevertHealthLogToRevisionRequest,
+) (*pbhealth.RevertHealthLogToRevisionResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	version, err := h.db.RevertMentalHealthLogToRevision(ctx, req.UserID, req.Id, req.RevisionVersion, req.ExpectedVersion)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return &pbhealth.RevertHealthLogToRevisionResponse{
+			Success: false,
+		}, repositoryError(err, "Error reverting mental health log")
+	}
 
-	return nil, nil
+	h.logger.Infof("Successfully reverted mental health log with ID %v to version %v\n", req.Id, req.RevisionVersion)
+
+	return &pbhealth.RevertHealthLogToRevisionResponse{Success: true, Version: version}, nil
 }
 
+func (h *HealthService) GrantAccess(
+	ctx context.Context,
+	req *pbhealth.GrantAccessRequest,
+) (*pbhealth.GrantAccessResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if req.GranteeID <= 0 || req.GranteeID == req.UserID {
+		return nil, status.Errorf(codes.InvalidArgument, "Grantee must be another user")
+	}
+	if req.ExpiresAt == nil || !req.ExpiresAt.AsTime().After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Expiry must be in the future")
+	}
+	if _, ok := pbhealth.GrantScope_name[int32(req.Scope)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown grant scope %v", req.Scope)
+	}
 
+	grant := &pbhealth.AccessGrant{
+		OwnerID:   req.UserID,
+		GranteeID: req.GranteeID,
+		Scope:     req.Scope,
+		CreatedAt: timestamppb.Now(),
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	err := h.db.SaveAccessGrant(ctx, grant)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Error granting access")
+	}
+
+	h.logger.Infof("Successfully granted user %v %v access to health data of user %v\n", req.GranteeID, req.Scope, req.UserID)
+
+	return &pbhealth.GrantAccessResponse{Grant: grant}, nil
+}
+
+func (h *HealthService) RevokeAccess(
+	ctx context.Context,
+	req *pbhealth.RevokeAccessRequest,
+) (*pbhealth.RevokeAccessResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	err := h.db.DeleteAccessGrant(ctx, req.UserID, req.GranteeID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return &pbhealth.RevokeAccessResponse{
+			Success: false,
+		}, repositoryError(err, "Error revoking access")
+	}
+
+	h.logger.Infof("Successfully revoked access of user %v to health data of user %v\n", req.GranteeID, req.UserID)
+
+	return &pbhealth.RevokeAccessResponse{Success: true}, nil
+}
+
+func (h *HealthService) ListGrants(
+	ctx context.Context,
+	req *pbhealth.ListGrantsRequest,
+) (*pbhealth.ListGrantsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	given, err := h.db.GetAccessGrantsByOwner(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Error getting access grants")
+	}
+
+	received, err := h.db.GetAccessGrantsByGrantee(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, status.Errorf(codes.Internal, "Error getting access grants")
+	}
+
+	return &pbhealth.ListGrantsResponse{GrantsGiven: given, GrantsReceived: received}, nil
+}
+
+// authorizeAccess - make sure the authenticated caller is the user whose health data the request is for, or holds an
+// unexpired grant from them covering scope
+func (h *HealthService) authorizeAccess(ctx context.Context, userID int64, scope pbhealth.GrantScope) error {
+	granted, err := h.grantedScope(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// scopes are ordered, each one sharing everything the ones before it do
+	if granted < scope {
+		return status.Errorf(codes.PermissionDenied, "Cannot access health data of another user")
+	}
+
+	return nil
+}
+
+// grantedScope - how much of the user's health data the authenticated caller can see, which is everything for the
+// user themselves
+func (h *HealthService) grantedScope(ctx context.Context, userID int64) (pbhealth.GrantScope, error) {
+	err := authorizeUser(ctx, userID)
+	if err == nil {
+		return pbhealth.GrantScope_SCORES_AND_JOURNALS, nil
+	} else if status.Code(err) != codes.PermissionDenied {
+		return 0, err
+	}
+
+	caller, _ := auth.CallerFromContext(ctx)
+	grant, grantErr := h.db.GetAccessGrant(ctx, userID, caller)
+	if status.Code(grantErr) == codes.NotFound {
+		return 0, err
+	} else if grantErr != nil {
+		h.logger.Errorf("%v", grantErr)
+		return 0, status.Errorf(codes.Internal, "Error checking access grants")
+	}
+
+	return grant.Scope, nil
+}
+
+// withoutJournals - copies of logs keeping only their scores, for callers whose grant does not share journals.
+// Tags go too, as they describe the day as much as the journal does.
+func withoutJournals(logs []*pbhealth.MentalHealthLog) []*pbhealth.MentalHealthLog {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0, len(logs))
+	for _, healthLog := range logs {
+		scoresOnly := proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+		scoresOnly.JournalName = ""
+		scoresOnly.Tags = nil
+		toReturn = append(toReturn, scoresOnly)
+	}
+	return toReturn
+}
+
+// authorizeUser - make sure the authenticated caller is the user whose health data the request is for
+func authorizeUser(ctx context.Context, userID int64) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Caller is not authenticated")
+	}
+	if caller != userID {
+		return status.Errorf(codes.PermissionDenied, "Cannot access health data of another user")
+	}
+	return nil
+}
+
+// validateLogEntry - reject a log whose score is out of range or whose dimension scores or tags are malformed
+func validateLogEntry(healthLog *pbhealth.MentalHealthLog) error {
+	if err := score.Validate(healthLog.GetScore()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := dimension.Validate(healthLog.GetDimensionScores()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := tag.Validate(healthLog.GetTags()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
+
+// validateDates - reject a request carrying a date that is not a day of the calendar, wherever it is set
+func validateDates(req proto.Message) error {
+	if err := date.ValidateFields(req); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
+
+// normalizeLogTime - a copy of healthLog with its date, time and time zone in agreement, leaving the request as it
+// was received
+func normalizeLogTime(healthLog *pbhealth.MentalHealthLog) (*pbhealth.MentalHealthLog, error) {
+	if healthLog == nil {
+		return nil, nil
+	}
+	normalized := proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+	if err := logtime.Normalize(normalized); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return normalized, nil
+}
+
+// hasPath - whether an update mask names path
+func hasPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLogTimePath - whether an update mask touches when a log was made
+func hasLogTimePath(paths []string) bool {
+	for _, timePath := range database.LogTimePaths {
+		if hasPath(paths, timePath) {
+			return true
+		}
+	}
+	return false
+}
+
+// withLogTimePaths - paths with every log time path added, as the date, time and time zone of a log only change
+// together. An empty mask already means every field.
+func withLogTimePaths(paths []string) []string {
+	if len(paths) == 0 {
+		return paths
+	}
+	toReturn := append([]string{}, paths...)
+	for _, timePath := range database.LogTimePaths {
+		if !hasPath(paths, timePath) {
+			toReturn = append(toReturn, timePath)
+		}
+	}
+	return toReturn
+}
+
+// repositoryError - pass on errors the repository reports about the request itself, and hide any other
+// failure behind msg
+func repositoryError(err error, msg string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.Aborted:
+		return err
+	}
+	return status.Errorf(codes.Internal, msg)
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/kic/health/pkg/database"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// metadata key clients can send an idempotency key under instead of the request field
+	idempotencyKeyHeader = "idempotency-key"
+
+	defaultIdempotencyWindow = 24 * time.Hour
+
+	// how long a key stays reserved by a request still in progress, so one that never finishes, from a crash for
+	// instance, blocks retries for a minute rather than the whole window
+	idempotencyLease = time.Minute
+
+	// how long saving or releasing a key may take, however the request itself ended
+	idempotencyStoreTimeout = 10 * time.Second
+)
+
+// requestIdempotencyKey - the idempotency key set on the request, falling back to the one in the metadata
+func requestIdempotencyKey(ctx context.Context, fromRequest string) string {
+	if fromRequest != "" {
+		return fromRequest
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+// newReservationToken - a random token identifying one reservation of an idempotency key
+func newReservationToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// withIdempotency - run handler, which fills in res, at most once per idempotency key within the idempotency
+// window. Retries with the same key get the response of the first call instead of running handler again.
+func (h *HealthService) withIdempotency(
+	ctx context.Context,
+	userID int64,
+	key string,
+	method string,
+	req proto.Message,
+	res proto.Message,
+	handler func() error,
+) error {
+	if key == "" {
+		return handler()
+	}
+
+	// the key may be sent in the request or in its metadata, and retries must match whichever way they send it
+	hashedReq := proto.Clone(req)
+	if field := hashedReq.ProtoReflect().Descriptor().Fields().ByName("idempotencyKey"); field != nil {
+		hashedReq.ProtoReflect().Clear(field)
+	}
+	rawReq, err := proto.MarshalOptions{Deterministic: true}.Marshal(hashedReq)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error reading request")
+	}
+	requestHash := sha256.Sum256(rawReq)
+
+	lease := idempotencyLease
+	if h.idempotencyWindow < lease {
+		lease = h.idempotencyWindow
+	}
+	token, err := newReservationToken()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error checking idempotency key")
+	}
+	existing, err := h.db.ReserveIdempotencyKey(ctx, &database.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Token:       token,
+		Method:      method,
+		RequestHash: requestHash[:],
+		ExpiresAt:   time.Now().Add(lease),
+	})
+	if err != nil {
+		h.logger.Errorf("cannot reserve idempotency key: %v", err)
+		return status.Errorf(codes.Internal, "Error checking idempotency key")
+	}
+
+	if existing != nil {
+		if existing.Method != method || !bytes.Equal(existing.RequestHash, requestHash[:]) {
+			return status.Errorf(codes.InvalidArgument, "Idempotency key was already used for a different request")
+		}
+		if !existing.Completed {
+			return status.Errorf(codes.Aborted, "A request with this idempotency key is still in progress")
+		}
+
+		h.logger.Infof("Replaying response for idempotency key %v\n", key)
+		if err := proto.Unmarshal(existing.Response, res); err != nil {
+			return status.Errorf(codes.Internal, "Error reading saved response")
+		}
+		return nil
+	}
+
+	handlerErr := handler()
+
+	// the request context may already be done, from the deadline that failed the handler for instance
+	storeCtx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+	defer cancel()
+
+	if handlerErr != nil {
+		// let the client retry a failed request under the same key
+		if releaseErr := h.db.ReleaseIdempotencyKey(storeCtx, userID, key, token); releaseErr != nil {
+			h.logger.Errorf("cannot release idempotency key: %v", releaseErr)
+		}
+		return handlerErr
+	}
+
+	rawRes, err := proto.Marshal(res)
+	if err == nil {
+		err = h.db.CompleteIdempotencyKey(storeCtx, userID, key, token, rawRes, time.Now().Add(h.idempotencyWindow))
+	}
+	if err != nil {
+		h.logger.Errorf("cannot save response for idempotency key: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/dimension"
+	"github.com/kic/health/pkg/importer"
+	"github.com/kic/health/pkg/logtime"
+	pbcommon "github.com/kic/health/pkg/proto/common"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/score"
+	"github.com/kic/health/pkg/tag"
+)
+
+// the most rows accepted in one batch of an import
+const maxImportBatchSize = 1000
+
+// ImportHealthData - each batch is validated and written as it arrives, so a failure part way through keeps the
+// batches before it
+func (h *HealthService) ImportHealthData(
+	stream pbhealth.HealthTracking_ImportHealthDataServer,
+) error {
+	ctx := stream.Context()
+
+	res := &pbhealth.ImportHealthDataResponse{
+		Ids:    &pbhealth.HealthLogIDs{Ids: make([]string, 0)},
+		Errors: make([]*pbhealth.ImportRowError, 0),
+	}
+	// dates imported by earlier batches, so rows of the import are also deduped against each other
+	imported := make(map[string]bool)
+
+	var userID int64
+	var row uint32
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if first {
+			if err = authorizeUser(ctx, req.UserID); err != nil {
+				return err
+			}
+			userID = req.UserID
+		} else if req.UserID != userID {
+			return status.Errorf(codes.InvalidArgument, "Every batch of an import must be for the same user")
+		}
+		if len(req.HealthData) > maxImportBatchSize {
+			return status.Errorf(codes.InvalidArgument, "Import batches can hold at most %v rows", maxImportBatchSize)
+		}
+
+		err = h.importBatch(ctx, userID, row, req.HealthData, make(map[uint32]*pbhealth.ImportRowError), imported, res)
+		if err != nil {
+			return err
+		}
+		row += uint32(len(req.HealthData))
+	}
+
+	res.NumImported = uint32(len(res.Ids.Ids))
+	h.logger.Infof("Successfully imported %v of %v mental health logs of user %v\n", res.NumImported, row, userID)
+
+	return stream.SendAndClose(res)
+}
+
+func (h *HealthService) ImportExternalHealthData(
+	ctx context.Context,
+	req *pbhealth.ImportExternalHealthDataRequest,
+) (*pbhealth.ImportHealthDataResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	format, ok := importer.Get(req.Format)
+	if !ok {
+		names := make([]string, 0)
+		for _, format := range importer.Formats() {
+			names = append(names, format.Name)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown import format %q, expected one of %v", req.Format, strings.Join(names, ", "))
+	}
+
+	if len(req.Data) > importer.MaxExportSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Export is %v bytes, the largest that can be imported is %v", len(req.Data), importer.MaxExportSize)
+	}
+
+	records, err := format.Parse(bytes.NewReader(req.Data))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot read %v export: %v", format.Name, err)
+	}
+
+	res := &pbhealth.ImportHealthDataResponse{
+		Ids:    &pbhealth.HealthLogIDs{Ids: make([]string, 0)},
+		Errors: make([]*pbhealth.ImportRowError, 0),
+	}
+	imported := make(map[string]bool)
+
+	for start := 0; start < len(records); start += maxImportBatchSize {
+		end := start + maxImportBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		healthData := make([]*pbhealth.MentalHealthLog, 0, end-start)
+		rowErrors := make(map[uint32]*pbhealth.ImportRowError)
+		for i, record := range records[start:end] {
+			if record.Err != nil {
+				row := uint32(start + i)
+				rowErrors[row] = &pbhealth.ImportRowError{Row: row, Reason: pbhealth.ImportRowErrorReason_INVALID, Message: record.Err.Error()}
+			}
+			healthData = append(healthData, record.HealthLog)
+		}
+
+		if err = h.importBatch(ctx, req.UserID, uint32(start), healthData, rowErrors, imported, res); err != nil {
+			return nil, err
+		}
+	}
+
+	res.NumImported = uint32(len(res.Ids.Ids))
+	h.logger.Infof("Successfully imported %v of %v mental health logs of user %v from %v\n", res.NumImported, len(records), req.UserID, format.Name)
+
+	return res, nil
+}
+
+// importBatch - add the rows of a batch that are valid and have a date without an entry, reporting the others along
+// with the rows already in rowErrors
+func (h *HealthService) importBatch(
+	ctx context.Context,
+	userID int64,
+	firstRow uint32,
+	healthData []*pbhealth.MentalHealthLog,
+	rowErrors map[uint32]*pbhealth.ImportRowError,
+	imported map[string]bool,
+	res *pbhealth.ImportHealthDataResponse,
+) error {
+	rowError := func(row uint32, reason pbhealth.ImportRowErrorReason, format string, args ...interface{}) {
+		rowErrors[row] = &pbhealth.ImportRowError{Row: row, Reason: reason, Message: fmt.Sprintf(format, args...)}
+	}
+
+	// the rows are normalized, and the fields the repository manages cleared, on copies, leaving the received
+	// messages as they were
+	newLogs := make([]*pbhealth.MentalHealthLog, len(healthData))
+	var startDate, endDate *pbcommon.Date
+	for i, healthLog := range healthData {
+		row := firstRow + uint32(i)
+		if _, ok := rowErrors[row]; ok {
+			continue
+		}
+		newLog := proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+		if err := logtime.Normalize(newLog); err != nil {
+			rowError(row, pbhealth.ImportRowErrorReason_INVALID, err.Error())
+			continue
+		}
+		if msg := invalidImportRow(userID, newLog); msg != "" {
+			rowError(row, pbhealth.ImportRowErrorReason_INVALID, msg)
+			continue
+		}
+		newLogs[i] = newLog
+		if startDate == nil || date.Before(newLog.LogDate, startDate) {
+			startDate = newLog.LogDate
+		}
+		if endDate == nil || date.After(newLog.LogDate, endDate) {
+			endDate = newLog.LogDate
+		}
+	}
+
+	if startDate == nil {
+		res.Errors = append(res.Errors, sortedRowErrors(firstRow, len(healthData), rowErrors)...)
+		return nil
+	}
+
+	existing, err := h.db.GetAllMentalHealthLogsInRange(ctx, userID, startDate, endDate)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return repositoryError(err, "Error importing health data")
+	}
+	existingDates := make(map[string]bool)
+	for _, healthLog := range existing {
+		existingDates[date.Format(healthLog.LogDate)] = true
+	}
+
+	toAdd := make([]*pbhealth.MentalHealthLog, 0, len(healthData))
+	for i, newLog := range newLogs {
+		row := firstRow + uint32(i)
+		if _, ok := rowErrors[row]; ok {
+			continue
+		}
+
+		key := date.Format(newLog.LogDate)
+		if existingDates[key] {
+			rowError(row, pbhealth.ImportRowErrorReason_DUPLICATE, "An entry for %v already exists", key)
+			continue
+		}
+		if imported[key] {
+			rowError(row, pbhealth.ImportRowErrorReason_DUPLICATE, "An earlier row of the import is for %v", key)
+			continue
+		}
+		imported[key] = true
+
+		newLog.UserID = userID
+		newLog.Id = ""
+		newLog.Version = 0
+		newLog.DeletedAt = nil
+		toAdd = append(toAdd, newLog)
+	}
+
+	if len(toAdd) > 0 {
+		ids, err := h.db.AddMentalHealthLogs(ctx, toAdd)
+		if err != nil {
+			h.logger.Errorf("%v", err)
+			return repositoryError(err, "Error importing health data")
+		}
+		res.Ids.Ids = append(res.Ids.Ids, ids...)
+	}
+
+	res.Errors = append(res.Errors, sortedRowErrors(firstRow, len(healthData), rowErrors)...)
+
+	return nil
+}
+
+// invalidImportRow - why a row cannot be imported, or the empty string if it can
+func invalidImportRow(userID int64, healthLog *pbhealth.MentalHealthLog) string {
+	if healthLog.LogDate == nil {
+		return "logDate or loggedAt is required"
+	}
+	if err := date.Validate(healthLog.LogDate); err != nil {
+		return fmt.Sprintf("%v is not a valid date, %v", date.Format(healthLog.LogDate), err)
+	}
+	if err := score.Validate(healthLog.Score); err != nil {
+		return err.Error()
+	}
+	if err := dimension.Validate(healthLog.DimensionScores); err != nil {
+		return err.Error()
+	}
+	if err := tag.Validate(healthLog.Tags); err != nil {
+		return err.Error()
+	}
+	if healthLog.UserID != 0 && healthLog.UserID != userID {
+		return "Cannot import health data for another user"
+	}
+	return ""
+}
+
+func sortedRowErrors(firstRow uint32, numRows int, rowErrors map[uint32]*pbhealth.ImportRowError) []*pbhealth.ImportRowError {
+	toReturn := make([]*pbhealth.ImportRowError, 0, len(rowErrors))
+	for row := firstRow; row < firstRow+uint32(numRows); row++ {
+		if rowError, ok := rowErrors[row]; ok {
+			toReturn = append(toReturn, rowError)
+		}
+	}
+	return toReturn
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/kic/health/pkg/database"
+	"go.uber.org/zap"
+)
+
+const keyRotationInterval = time.Hour
+
+// RunKeyRotation - rewrap data keys with the current master key, checking every hour until ctx is cancelled so
+// keys left on an earlier master key are picked up without a restart
+func RunKeyRotation(ctx context.Context, db *database.EncryptedRepository, logger *zap.SugaredLogger) {
+	ticker := time.NewTicker(keyRotationInterval)
+	defer ticker.Stop()
+
+	for {
+		numRotated, err := db.RotateDataKeys(ctx)
+		if err != nil {
+			logger.Errorf("cannot rotate data keys: %v", err)
+		}
+		if numRotated > 0 {
+			logger.Infof("Rewrapped %v data keys with the current master key\n", numRotated)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
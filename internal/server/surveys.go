@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/internal/auth"
+	"github.com/kic/health/pkg/logging"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/survey"
+)
+
+func (h *HealthService) GetSurvey(
+	ctx context.Context,
+	req *pbhealth.GetSurveyRequest,
+) (*pbhealth.GetSurveyResponse, error) {
+	// surveys are not anyone's health data, so any authenticated user can read them
+	if _, ok := auth.CallerFromContext(ctx); !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authenticated")
+	}
+	if h.surveys == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Surveys are not configured")
+	}
+
+	published, err := h.surveys.GetSurvey(ctx, req.SurveyID, req.Version)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting survey")
+	}
+
+	return &pbhealth.GetSurveyResponse{Survey: published}, nil
+}
+
+func (h *HealthService) SubmitSurveyResponse(
+	ctx context.Context,
+	req *pbhealth.SubmitSurveyResponseRequest,
+) (*pbhealth.SubmitSurveyResponseResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if h.surveys == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Surveys are not configured")
+	}
+
+	published, err := h.surveys.GetSurvey(ctx, req.SurveyID, req.SurveyVersion)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting survey")
+	}
+
+	answers, scores, err := survey.Respond(published, req.Answers)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	response := &pbhealth.SurveyResponse{
+		UserID:        req.UserID,
+		SurveyID:      published.Id,
+		SurveyVersion: published.Version,
+		SubmittedAt:   timestamppb.Now(),
+		Answers:       answers,
+		Scores:        scores,
+	}
+	if _, err = h.surveys.AddSurveyResponse(ctx, response); err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error adding survey response to database")
+	}
+
+	h.logger.Infof("Successfully added survey response: %v\n", logging.Redact(response))
+
+	return &pbhealth.SubmitSurveyResponseResponse{Response: response}, nil
+}
+
+func (h *HealthService) ListSurveyResponses(
+	ctx context.Context,
+	req *pbhealth.ListSurveyResponsesRequest,
+) (*pbhealth.ListSurveyResponsesResponse, error) {
+	// responses can hold free text, so they are shared like journals
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_AND_JOURNALS); err != nil {
+		return nil, err
+	}
+	if h.surveys == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Surveys are not configured")
+	}
+
+	responses, err := h.surveys.GetSurveyResponses(ctx, req.UserID, req.SurveyID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting survey responses")
+	}
+
+	return &pbhealth.ListSurveyResponsesResponse{Responses: responses}, nil
+}
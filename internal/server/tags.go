@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kic/health/pkg/analytics"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/tag"
+)
+
+// ListTags - tags say as much about a user's day as their journal does, so grantees need the journal scope
+func (h *HealthService) ListTags(
+	ctx context.Context,
+	req *pbhealth.ListTagsRequest,
+) (*pbhealth.ListTagsResponse, error) {
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_AND_JOURNALS); err != nil {
+		return nil, err
+	}
+
+	usage, err := h.db.GetTagUsage(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting tags")
+	}
+
+	h.logger.Infof("Successfully got %v tags\n", len(usage))
+
+	return &pbhealth.ListTagsResponse{Tags: usage}, nil
+}
+
+func (h *HealthService) RenameTag(
+	ctx context.Context,
+	req *pbhealth.RenameTagRequest,
+) (*pbhealth.RenameTagResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateTagNames(req.To, req.From); err != nil {
+		return nil, err
+	}
+	if req.From == req.To {
+		return nil, status.Errorf(codes.InvalidArgument, "The new tag name must be different")
+	}
+
+	usage, err := h.db.GetTagUsage(ctx, req.UserID)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error renaming tag")
+	}
+	for _, tagUsage := range usage {
+		if tagUsage.Name == req.To {
+			return nil, status.Errorf(codes.AlreadyExists, "Tag %q is already in use, merge the tags instead", req.To)
+		}
+	}
+
+	numUpdated, err := h.db.ReplaceTags(ctx, req.UserID, []string{req.From}, req.To)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error renaming tag")
+	}
+
+	return &pbhealth.RenameTagResponse{EntriesUpdated: numUpdated}, nil
+}
+
+func (h *HealthService) MergeTags(
+	ctx context.Context,
+	req *pbhealth.MergeTagsRequest,
+) (*pbhealth.MergeTagsResponse, error) {
+	if err := authorizeUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if len(req.Sources) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one tag to merge is required")
+	}
+	if err := validateTagNames(req.Target, req.Sources...); err != nil {
+		return nil, err
+	}
+	for _, source := range req.Sources {
+		if source == req.Target {
+			return nil, status.Errorf(codes.InvalidArgument, "Tag %q cannot be merged into itself", source)
+		}
+	}
+
+	numUpdated, err := h.db.ReplaceTags(ctx, req.UserID, req.Sources, req.Target)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error merging tags")
+	}
+
+	return &pbhealth.MergeTagsResponse{EntriesUpdated: numUpdated}, nil
+}
+
+func (h *HealthService) GetTagImpact(
+	ctx context.Context,
+	req *pbhealth.GetTagImpactRequest,
+) (*pbhealth.GetTagImpactResponse, error) {
+	if err := h.authorizeAccess(ctx, req.UserID, pbhealth.GrantScope_SCORES_AND_JOURNALS); err != nil {
+		return nil, err
+	}
+	if err := validateDates(req); err != nil {
+		return nil, err
+	}
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Start and end dates are required")
+	}
+
+	days := analytics.RangeDays(req.StartDate, req.EndDate)
+	if days <= 0 || days > maxTrendRangeDays {
+		return nil, status.Errorf(codes.InvalidArgument, "Date range must span 1 to %v days", maxTrendRangeDays)
+	}
+
+	logs, err := h.db.GetAllMentalHealthLogsInRange(ctx, req.UserID, req.StartDate, req.EndDate)
+	if err != nil {
+		h.logger.Errorf("%v", err)
+		return nil, repositoryError(err, "Error getting health data for tag impact")
+	}
+
+	h.logger.Infof("Computing tag impact over %v mental health logs\n", len(logs))
+
+	return &pbhealth.GetTagImpactResponse{Tags: analytics.TagImpact(logs, req.StartDate, req.EndDate)}, nil
+}
+
+// validateTagNames - reject tag names a log could not carry
+func validateTagNames(target string, sources ...string) error {
+	for _, name := range append([]string{target}, sources...) {
+		if err := tag.ValidateName(name); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/kic/health/pkg/database"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTrashRetention - how long deleted health logs can be restored before they are purged for good
+	DefaultTrashRetention = 30 * 24 * time.Hour
+
+	trashPurgeInterval = time.Hour
+)
+
+// RunTrashPurger - permanently remove health logs that have been in the trash for longer than retention, checking
+// every hour until ctx is cancelled
+func RunTrashPurger(ctx context.Context, db database.Repository, logger *zap.SugaredLogger, retention time.Duration) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		numPurged, err := db.PurgeDeletedMentalHealthLogs(ctx, time.Now().Add(-retention))
+		if err != nil {
+			logger.Errorf("cannot purge deleted mental health logs: %v", err)
+		} else if numPurged > 0 {
+			logger.Infof("Purged %v deleted mental health logs\n", numPurged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"net"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -13,19 +15,33 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/kic/health/internal/audit"
+	"github.com/kic/health/internal/auth"
 	"github.com/kic/health/internal/server"
 	"github.com/kic/health/pkg/database"
+	"github.com/kic/health/pkg/encryption"
+	"github.com/kic/health/pkg/importer"
+	"github.com/kic/health/pkg/logging"
 	pbhealth "github.com/kic/health/pkg/proto/health"
 )
 
 
+// full names of the services whose calls are audited
+const (
+	healthTrackingServiceName = "kic.health.HealthTracking"
+	healthAdminServiceName    = "kic.health.HealthAdmin"
+)
+
+// maxRecvMsgSize - the largest message the server accepts, which fits the largest importable export along with the
+// other fields of its request
+const maxRecvMsgSize = importer.MaxExportSize + 1<<20
+
 // DBRepositorySetup - configure and set up the database repository instance, returning the repository
 // and the underlying mongo client for disconnecting on exit
-func DBRepositorySetup(logger *zap.SugaredLogger, dbPrefix string) (database.Repository, *mongo.Client) {
+func DBRepositorySetup(logger *zap.SugaredLogger, dbPrefix string) (*database.EncryptedRepository, *mongo.Client) {
 	MongoURI := os.Getenv("MONGO_URI")
-	IsProduction := os.Getenv("PRODUCTION") != ""
 
-	logger.Infof("MongoURI: %v\n", MongoURI)
+	logger.Infof("MongoURI: %v\n", logging.RedactURI(MongoURI))
 
 	ctx := context.Background()
 
@@ -41,20 +57,65 @@ func DBRepositorySetup(logger *zap.SugaredLogger, dbPrefix string) (database.Rep
 		logger.Fatalf("Couldn't ping mongo: %v", err)
 	}
 
-	var dbName string
-	if IsProduction {
-		dbName = dbPrefix + "-prod"
-	} else {
-		dbName = dbPrefix + "-test"
-	}
+	dbName := databaseName(dbPrefix)
 
 	repository := database.NewMongoRepository(mongoClient, logger)
 	repository.SetCollections(dbName)
-	return repository, mongoClient
+
+	// journals are encrypted with per user data keys, wrapped by the master key from config. Master keys being
+	// rotated out stay in PREVIOUS_MASTER_KEYS until every data key has been rewrapped.
+	keyring, err := encryption.ParseKeyring(os.Getenv("MASTER_KEY_ID"), os.Getenv("MASTER_KEY"), os.Getenv("PREVIOUS_MASTER_KEYS"))
+	if err != nil {
+		logger.Fatalf("Invalid MASTER_KEY_ID, MASTER_KEY or PREVIOUS_MASTER_KEYS: %v", err)
+	}
+
+	return database.NewEncryptedRepository(repository, repository, keyring, logger), mongoClient
+}
+
+// databaseName - the mongo database used for the given prefix in the current environment
+func databaseName(dbPrefix string) string {
+	if os.Getenv("PRODUCTION") != "" {
+		return dbPrefix + "-prod"
+	}
+	return dbPrefix + "-test"
+}
+
+// AssessmentRepositorySetup - set up the repository of PHQ-9 and GAD-7 assessments, kept in the same database as the
+// health logs
+func AssessmentRepositorySetup(logger *zap.SugaredLogger, mongoClient *mongo.Client, dbPrefix string) database.AssessmentRepository {
+	repository := database.NewMongoAssessmentRepository(mongoClient, logger)
+	repository.SetCollections(databaseName(dbPrefix))
+	return repository
+}
+
+// SurveyRepositorySetup - set up the repository of survey definitions and responses, kept in the same database as
+// the health logs. Free text answers are encrypted with the data keys of logs.
+func SurveyRepositorySetup(logger *zap.SugaredLogger, mongoClient *mongo.Client, dbPrefix string, logs *database.EncryptedRepository) database.SurveyRepository {
+	repository := database.NewMongoSurveyRepository(mongoClient, logger)
+	repository.SetCollections(databaseName(dbPrefix))
+	return database.NewEncryptedSurveyRepository(repository, logs)
+}
+
+// AuditSetup - configure where audit events are kept: the "audit" collection of the database by default, or the
+// JSON lines file at AUDIT_LOG_PATH when AUDIT_SINK is "file"
+func AuditSetup(logger *zap.SugaredLogger, mongoClient *mongo.Client, dbPrefix string) audit.Sink {
+	switch sink := os.Getenv("AUDIT_SINK"); sink {
+	case "", "mongo":
+		return audit.NewMongoSink(mongoClient.Database(databaseName(dbPrefix)).Collection("audit"), logger)
+	case "file":
+		path := os.Getenv("AUDIT_LOG_PATH")
+		if path == "" {
+			logger.Fatalf("AUDIT_LOG_PATH is required when AUDIT_SINK is file")
+		}
+		return audit.NewFileSink(path)
+	default:
+		logger.Fatalf("Invalid AUDIT_SINK %v", sink)
+		return nil
+	}
 }
 
 // GRPCSetup - configure the grpc server and being listening
-func GRPCSetup(logger *zap.SugaredLogger, db database.Repository) *grpc.Server {
+func GRPCSetup(logger *zap.SugaredLogger, db database.Repository, assessments database.AssessmentRepository, surveys database.SurveyRepository, auditSink audit.Sink) *grpc.Server {
 	ListenAddress := ":" + os.Getenv("PORT")
 
 	listener, err := net.Listen("tcp", ListenAddress)
@@ -62,7 +123,23 @@ func GRPCSetup(logger *zap.SugaredLogger, db database.Repository) *grpc.Server {
 		logger.Fatalf("Unable to listen on %v: %v", ListenAddress, err)
 	}
 
-	grpcServer := grpc.NewServer()
+	SecretKey := os.Getenv("SECRET_KEY")
+	if SecretKey == "" {
+		logger.Fatalf("SECRET_KEY is required to authenticate requests")
+	}
+
+	authenticator := auth.NewAuthenticator([]byte(SecretKey), logger)
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		grpc.ChainUnaryInterceptor(
+			authenticator.UnaryServerInterceptor(),
+			audit.UnaryServerInterceptor(auditSink, logger, healthTrackingServiceName, healthAdminServiceName),
+		),
+		grpc.ChainStreamInterceptor(
+			authenticator.StreamServerInterceptor(),
+			audit.StreamServerInterceptor(auditSink, logger, healthTrackingServiceName, healthAdminServiceName),
+		),
+	)
 
 
 	if err != nil {
@@ -70,8 +147,35 @@ func GRPCSetup(logger *zap.SugaredLogger, db database.Repository) *grpc.Server {
 	}
 
 	healthService := server.NewHealthService(db, logger)
+	if window := os.Getenv("IDEMPOTENCY_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			logger.Fatalf("Invalid IDEMPOTENCY_WINDOW %v: %v", window, err)
+		}
+		healthService.SetIdempotencyWindow(duration)
+	}
+	healthService.SetAssessmentRepository(assessments)
+	healthService.SetSurveyRepository(surveys)
 	pbhealth.RegisterHealthTrackingServer(grpcServer, healthService)
 
+	adminService := server.NewAdminService(db, logger)
+	adminIDs := make([]int64, 0)
+	for _, value := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		adminID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			logger.Fatalf("Invalid ADMIN_USER_IDS %v: %v", value, err)
+		}
+		adminIDs = append(adminIDs, adminID)
+	}
+	adminService.SetAdmins(adminIDs)
+	adminService.SetAuditSink(auditSink)
+	adminService.SetAssessmentRepository(assessments)
+	adminService.SetSurveyRepository(surveys)
+	pbhealth.RegisterHealthAdminServer(grpcServer, adminService)
+
 	reflection.Register(grpcServer)
 
 	go func() {
@@ -85,3 +189,32 @@ func GRPCSetup(logger *zap.SugaredLogger, db database.Repository) *grpc.Server {
 
 	return grpcServer
 }
+
+// TrashPurgeSetup - start purging health logs that have been in the trash for longer than TRASH_RETENTION in the
+// background, returning a function that stops it
+func TrashPurgeSetup(logger *zap.SugaredLogger, db database.Repository) context.CancelFunc {
+	retention := server.DefaultTrashRetention
+	if value := os.Getenv("TRASH_RETENTION"); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Fatalf("Invalid TRASH_RETENTION %v: %v", value, err)
+		}
+		retention = duration
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go server.RunTrashPurger(ctx, db, logger, retention)
+
+	logger.Infof("Purging deleted health logs after %v", retention)
+
+	return cancel
+}
+
+// KeyRotationSetup - start rewrapping data keys with the current master key in the background, returning a function
+// that stops it
+func KeyRotationSetup(logger *zap.SugaredLogger, db *database.EncryptedRepository) context.CancelFunc {
+	ctx, cancel := context.WithCancel(context.Background())
+	go server.RunKeyRotation(ctx, db, logger)
+
+	return cancel
+}
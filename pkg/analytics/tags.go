@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	pbcommon "github.com/kic/health/pkg/proto/common"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// taggedDay - the scores and tags of the logs on one day
+type taggedDay struct {
+	sum   float64
+	count int
+	tags  map[string]bool
+}
+
+// TagImpact - for every tag on the logs between start and end, inclusive, compare the mean daily score on days
+// with the tag to the mean on the other days with logs. Every day counts once however many logs it has.
+func TagImpact(logs []*pbhealth.MentalHealthLog, start *pbcommon.Date, end *pbcommon.Date) []*pbhealth.TagImpact {
+	startTime := date.ToTime(start, time.UTC)
+	endTime := date.ToTime(end, time.UTC)
+
+	days := make(map[time.Time]*taggedDay)
+	tags := make(map[string]bool)
+	for _, log := range logs {
+		if log.LogDate == nil {
+			continue
+		}
+
+		day := date.ToTime(log.LogDate, time.UTC)
+		if day.Before(startTime) || day.After(endTime) {
+			continue
+		}
+
+		dayLogs, ok := days[day]
+		if !ok {
+			dayLogs = &taggedDay{tags: make(map[string]bool)}
+			days[day] = dayLogs
+		}
+		dayLogs.sum += float64(log.Score)
+		dayLogs.count++
+		for _, name := range log.Tags {
+			dayLogs.tags[name] = true
+			tags[name] = true
+		}
+	}
+
+	toReturn := make([]*pbhealth.TagImpact, 0, len(tags))
+	for name := range tags {
+		var sumWith, sumWithout float64
+		impact := &pbhealth.TagImpact{Name: name}
+		for _, dayLogs := range days {
+			mean := dayLogs.sum / float64(dayLogs.count)
+			if dayLogs.tags[name] {
+				sumWith += mean
+				impact.DaysWith++
+			} else {
+				sumWithout += mean
+				impact.DaysWithout++
+			}
+		}
+
+		if impact.DaysWith > 0 {
+			impact.MeanWith = sumWith / float64(impact.DaysWith)
+		}
+		if impact.DaysWithout > 0 {
+			impact.MeanWithout = sumWithout / float64(impact.DaysWithout)
+		}
+		if impact.DaysWith > 0 && impact.DaysWithout > 0 {
+			impact.Difference = impact.MeanWith - impact.MeanWithout
+		}
+		toReturn = append(toReturn, impact)
+	}
+
+	sort.Slice(toReturn, func(i, j int) bool {
+		if toReturn[i].DaysWith != toReturn[j].DaysWith {
+			return toReturn[i].DaysWith > toReturn[j].DaysWith
+		}
+		return toReturn[i].Name < toReturn[j].Name
+	})
+
+	return toReturn
+}
@@ -0,0 +1,207 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/dimension"
+	"github.com/kic/health/pkg/logtime"
+	pbcommon "github.com/kic/health/pkg/proto/common"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+const (
+	shortWindowDays = 7
+	longWindowDays  = 30
+
+	// LookbackDays - how many days before the start of a range are needed for the first rolling averages
+	LookbackDays = longWindowDays - 1
+)
+
+// bucket - running statistics for the scores that fall into one day, week, month or part of the day
+type bucket struct {
+	start time.Time
+	end   time.Time
+	sum   float64
+	min   int32
+	max   int32
+	count int32
+}
+
+func (b *bucket) add(score int32) {
+	if b.count == 0 || score < b.min {
+		b.min = score
+	}
+	if b.count == 0 || score > b.max {
+		b.max = score
+	}
+	b.sum += float64(score)
+	b.count++
+}
+
+func (b *bucket) toProto() *pbhealth.MoodBucket {
+	return &pbhealth.MoodBucket{
+		StartDate: date.FromTime(b.start),
+		EndDate:   date.FromTime(b.end),
+		Mean:      b.sum / float64(b.count),
+		Min:       b.min,
+		Max:       b.max,
+		Count:     b.count,
+	}
+}
+
+// MoodTrends - compute score statistics for the logs between start and end, inclusive. Logs from up to
+// LookbackDays before start are only used for rolling averages, logs outside of that are ignored. A non-empty
+// dimension follows that mood dimension's score instead of the overall one, skipping logs that do not rate it.
+func MoodTrends(logs []*pbhealth.MentalHealthLog, start *pbcommon.Date, end *pbcommon.Date, dimensionName string) *pbhealth.GetMoodTrendsResponse {
+	startTime := date.ToTime(start, time.UTC)
+	endTime := date.ToTime(end, time.UTC)
+	lookbackTime := startTime.AddDate(0, 0, -LookbackDays)
+
+	daily := make(map[time.Time]*bucket)
+	weekly := make(map[time.Time]*bucket)
+	monthly := make(map[time.Time]*bucket)
+	timesOfDay := make(map[pbhealth.TimeOfDay]*bucket)
+
+	for _, log := range logs {
+		if log.LogDate == nil {
+			continue
+		}
+
+		score := log.Score
+		if dimensionName != "" {
+			var ok bool
+			if score, ok = dimension.Score(log, dimensionName); !ok {
+				continue
+			}
+		}
+
+		day := date.ToTime(log.LogDate, time.UTC)
+		if day.Before(lookbackTime) || day.After(endTime) {
+			continue
+		}
+
+		dayBucket, ok := daily[day]
+		if !ok {
+			dayBucket = &bucket{start: day, end: day}
+			daily[day] = dayBucket
+		}
+		dayBucket.add(score)
+
+		if day.Before(startTime) {
+			continue
+		}
+
+		// weeks start on Monday
+		weekStart := date.ToTime(date.StartOfISOWeek(log.LogDate), time.UTC)
+		weekBucket, ok := weekly[weekStart]
+		if !ok {
+			weekBucket = &bucket{start: weekStart, end: date.ToTime(date.EndOfISOWeek(log.LogDate), time.UTC)}
+			weekly[weekStart] = weekBucket
+		}
+		weekBucket.add(score)
+
+		monthStart := date.ToTime(date.StartOfMonth(log.LogDate), time.UTC)
+		monthBucket, ok := monthly[monthStart]
+		if !ok {
+			monthBucket = &bucket{start: monthStart, end: date.ToTime(date.EndOfMonth(log.LogDate), time.UTC)}
+			monthly[monthStart] = monthBucket
+		}
+		monthBucket.add(score)
+
+		if partOfDay, ok := logtime.PartOfDay(log); ok {
+			partBucket, ok := timesOfDay[partOfDay]
+			if !ok {
+				partBucket = &bucket{}
+				timesOfDay[partOfDay] = partBucket
+			}
+			partBucket.add(score)
+		}
+	}
+
+	res := &pbhealth.GetMoodTrendsResponse{
+		Daily:           make([]*pbhealth.MoodBucket, 0),
+		Weekly:          sortedBuckets(weekly),
+		Monthly:         sortedBuckets(monthly),
+		RollingAverages: make([]*pbhealth.RollingAverage, 0),
+		TimesOfDay:      make([]*pbhealth.TimeOfDayBucket, 0),
+	}
+
+	for partOfDay := pbhealth.TimeOfDay_MORNING; partOfDay <= pbhealth.TimeOfDay_NIGHT; partOfDay++ {
+		if partBucket, ok := timesOfDay[partOfDay]; ok {
+			res.TimesOfDay = append(res.TimesOfDay, &pbhealth.TimeOfDayBucket{
+				TimeOfDay: partOfDay,
+				Mean:      partBucket.sum / float64(partBucket.count),
+				Count:     partBucket.count,
+			})
+		}
+	}
+
+	// running sums over the days since lookbackTime, so every window is a difference of two entries
+	var sums []float64
+	var counts []int32
+	var sum float64
+	var count int32
+	for day := lookbackTime; !day.After(endTime); day = day.AddDate(0, 0, 1) {
+		if dayBucket, ok := daily[day]; ok {
+			sum += dayBucket.sum
+			count += dayBucket.count
+			if !day.Before(startTime) {
+				res.Daily = append(res.Daily, dayBucket.toProto())
+			}
+		}
+		sums = append(sums, sum)
+		counts = append(counts, count)
+
+		if day.Before(startTime) {
+			continue
+		}
+
+		i := len(sums) - 1
+		average := &pbhealth.RollingAverage{Date: date.FromTime(day)}
+		average.SevenDayMean, average.SevenDayCount = windowMean(sums, counts, i, shortWindowDays)
+		average.ThirtyDayMean, average.ThirtyDayCount = windowMean(sums, counts, i, longWindowDays)
+		res.RollingAverages = append(res.RollingAverages, average)
+	}
+
+	return res
+}
+
+// LookbackStart - the earliest date whose logs affect the rolling averages of a range beginning on start
+func LookbackStart(start *pbcommon.Date) *pbcommon.Date {
+	return date.AddDays(start, -LookbackDays)
+}
+
+// RangeDays - the number of days between start and end, inclusive, or a negative number if end is before start
+func RangeDays(start *pbcommon.Date, end *pbcommon.Date) int {
+	return date.DaysBetween(start, end) + 1
+}
+
+// windowMean - mean and count of the scores in the days window ending at index i of the running sums
+func windowMean(sums []float64, counts []int32, i int, days int) (float64, int32) {
+	sum, count := sums[i], counts[i]
+	if i >= days {
+		sum -= sums[i-days]
+		count -= counts[i-days]
+	}
+
+	if count == 0 {
+		return 0, 0
+	}
+	return sum / float64(count), count
+}
+
+func sortedBuckets(buckets map[time.Time]*bucket) []*pbhealth.MoodBucket {
+	starts := make([]time.Time, 0, len(buckets))
+	for start := range buckets {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	toReturn := make([]*pbhealth.MoodBucket, 0, len(starts))
+	for _, start := range starts {
+		toReturn = append(toReturn, buckets[start].toProto())
+	}
+	return toReturn
+}
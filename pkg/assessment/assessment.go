@@ -0,0 +1,104 @@
+package assessment
+
+import (
+	"fmt"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// MaxResponse - the highest answer to an item, "nearly every day". Answers start at 0, "not at all".
+const MaxResponse = 3
+
+// Questionnaire - how a standardized questionnaire is scored
+type Questionnaire struct {
+	Type     pbhealth.AssessmentType
+	Name     string
+	NumItems int
+	// the lowest total score of each severity band, in increasing order
+	bands []band
+	// the smallest change in total score that is unlikely to be measurement error
+	reliableChange int32
+	// the index of the item about thoughts of self harm, or -1 if there is none
+	riskItem int
+}
+
+type band struct {
+	minTotal int32
+	severity pbhealth.AssessmentSeverity
+}
+
+var questionnaires = map[pbhealth.AssessmentType]*Questionnaire{
+	pbhealth.AssessmentType_PHQ9: {
+		Type:     pbhealth.AssessmentType_PHQ9,
+		Name:     "PHQ-9",
+		NumItems: 9,
+		bands: []band{
+			{0, pbhealth.AssessmentSeverity_MINIMAL},
+			{5, pbhealth.AssessmentSeverity_MILD},
+			{10, pbhealth.AssessmentSeverity_MODERATE},
+			{15, pbhealth.AssessmentSeverity_MODERATELY_SEVERE},
+			{20, pbhealth.AssessmentSeverity_SEVERE},
+		},
+		reliableChange: 6,
+		riskItem:       8,
+	},
+	pbhealth.AssessmentType_GAD7: {
+		Type:     pbhealth.AssessmentType_GAD7,
+		Name:     "GAD-7",
+		NumItems: 7,
+		bands: []band{
+			{0, pbhealth.AssessmentSeverity_MINIMAL},
+			{5, pbhealth.AssessmentSeverity_MILD},
+			{10, pbhealth.AssessmentSeverity_MODERATE},
+			{15, pbhealth.AssessmentSeverity_SEVERE},
+		},
+		reliableChange: 4,
+		riskItem:       -1,
+	},
+}
+
+// Get - the questionnaire of an assessment type
+func Get(assessmentType pbhealth.AssessmentType) (*Questionnaire, bool) {
+	questionnaire, ok := questionnaires[assessmentType]
+	return questionnaire, ok
+}
+
+// Score - fill in the total score, severity and risk flag of an assessment from its responses
+func (q *Questionnaire) Score(assessment *pbhealth.Assessment) error {
+	if len(assessment.Responses) != q.NumItems {
+		return fmt.Errorf("%v has %v items, got %v responses", q.Name, q.NumItems, len(assessment.Responses))
+	}
+
+	var total int32
+	for i, response := range assessment.Responses {
+		if response < 0 || response > MaxResponse {
+			return fmt.Errorf("response to item %v must be from 0 to %v", i+1, MaxResponse)
+		}
+		total += response
+	}
+
+	assessment.TotalScore = total
+	assessment.Severity = q.Severity(total)
+	assessment.RiskFlagged = q.riskItem >= 0 && assessment.Responses[q.riskItem] > 0
+
+	return nil
+}
+
+// Severity - the band a total score falls in
+func (q *Questionnaire) Severity(total int32) pbhealth.AssessmentSeverity {
+	severity := q.bands[0].severity
+	for _, b := range q.bands {
+		if total >= b.minTotal {
+			severity = b.severity
+		}
+	}
+	return severity
+}
+
+// ReliableChange - whether a change in total score is large enough to be unlikely to be measurement error
+func (q *Questionnaire) ReliableChange(change int32) bool {
+	if change < 0 {
+		change = -change
+	}
+	return change >= q.reliableChange
+}
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// grantActive - whether the grant still gives access at now
+func grantActive(grant *pbhealth.AccessGrant, now time.Time) bool {
+	return grant.ExpiresAt != nil && grant.ExpiresAt.AsTime().After(now)
+}
+
+// accessGrantKey - the key of the grant from owner to grantee in the in-memory store
+func accessGrantKey(ownerID int64, granteeID int64) string {
+	return fmt.Sprintf("%v/%v", ownerID, granteeID)
+}
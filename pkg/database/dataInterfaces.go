@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"time"
+
 	pbcommon "github.com/kic/health/pkg/proto/common"
 
 	pbhealth "github.com/kic/health/pkg/proto/health"
@@ -11,9 +13,61 @@ import (
 // enables the repository pattern so that we can swap out the database backend easily
 type Repository interface {
 	GetOverallScore(ctx context.Context, userID int64) (int32, error)
+	GetDimensionScore(ctx context.Context, userID int64, dimension string) (int32, bool, error)
 	GetAllMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error)
 	GetAllMentalHealthLogsByDate(ctx context.Context, userID int64, date *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error)
+	GetAllMentalHealthLogsInRange(ctx context.Context, userID int64, startDate *pbcommon.Date, endDate *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error)
+	GetMentalHealthLogsInRange(ctx context.Context, userID int64, query *LogRangeQuery) ([]*pbhealth.MentalHealthLog, string, error)
+	GetMentalHealthLogByID(ctx context.Context, userID int64, id string) (*pbhealth.MentalHealthLog, error)
 	AddMentalHealthLog(ctx context.Context, healthLog *pbhealth.MentalHealthLog) (string, error)
+	AddMentalHealthLogs(ctx context.Context, healthLogs []*pbhealth.MentalHealthLog) ([]string, error)
 	DeleteMentalHealthLogs(ctx context.Context, userID int64, date *pbcommon.Date, all bool) (uint32, error)
-	UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog) error
+	UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) error
+	UpdateMentalHealthLogByID(ctx context.Context, userID int64, id string, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) (int64, error)
+	DeleteMentalHealthLogByID(ctx context.Context, userID int64, id string, expectedVersion int64) error
+	GetMentalHealthLogRevisions(ctx context.Context, userID int64, id string) ([]*pbhealth.HealthLogRevision, error)
+	GetRevisionsOfMentalHealthLogs(ctx context.Context, userID int64, ids []string) ([]*pbhealth.HealthLogRevision, error)
+	RevertMentalHealthLogToRevision(ctx context.Context, userID int64, id string, revisionVersion int64, expectedVersion int64) (int64, error)
+	GetDeletedMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error)
+	RestoreMentalHealthLogs(ctx context.Context, userID int64, ids []string, all bool) (uint32, error)
+	PurgeDeletedMentalHealthLogs(ctx context.Context, deletedBefore time.Time) (uint32, error)
+	DeleteUserData(ctx context.Context, userID int64) (uint32, error)
+	RebuildScoreAggregates(ctx context.Context, userIDs []int64) (uint32, error)
+	MigrateLogTimes(ctx context.Context, location *time.Location) (uint32, error)
+	GetTagUsage(ctx context.Context, userID int64) ([]*pbhealth.TagUsage, error)
+	ReplaceTags(ctx context.Context, userID int64, sources []string, target string) (uint32, error)
+	ReserveIdempotencyKey(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, userID int64, key string, token string, response []byte, expiresAt time.Time) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int64, key string, token string) error
+	SaveAccessGrant(ctx context.Context, grant *pbhealth.AccessGrant) error
+	DeleteAccessGrant(ctx context.Context, ownerID int64, granteeID int64) error
+	GetAccessGrant(ctx context.Context, ownerID int64, granteeID int64) (*pbhealth.AccessGrant, error)
+	GetAccessGrantsByOwner(ctx context.Context, ownerID int64) ([]*pbhealth.AccessGrant, error)
+	GetAccessGrantsByGrantee(ctx context.Context, granteeID int64) ([]*pbhealth.AccessGrant, error)
+}
+
+// AssessmentRepository - interface for a data provider that stores standardized questionnaire results, kept apart
+// from the mental health logs
+type AssessmentRepository interface {
+	AddAssessment(ctx context.Context, assessment *pbhealth.Assessment) (string, error)
+	GetAssessments(ctx context.Context, userID int64, query *AssessmentQuery) ([]*pbhealth.Assessment, error)
+	DeleteUserAssessments(ctx context.Context, userID int64) (uint32, error)
+}
+
+// AssessmentQuery - which assessments of a user to get. Empty Types match every type, and unset dates leave that end
+// of the range open.
+type AssessmentQuery struct {
+	Types     []pbhealth.AssessmentType
+	StartDate *pbcommon.Date
+	EndDate   *pbcommon.Date
+}
+
+// SurveyRepository - interface for a data provider that stores survey definitions, by version, and the responses
+// users submit to them
+type SurveyRepository interface {
+	PublishSurvey(ctx context.Context, survey *pbhealth.Survey) (int64, error)
+	GetSurvey(ctx context.Context, id string, version int64) (*pbhealth.Survey, error)
+	AddSurveyResponse(ctx context.Context, response *pbhealth.SurveyResponse) (string, error)
+	GetSurveyResponses(ctx context.Context, userID int64, surveyID string) ([]*pbhealth.SurveyResponse, error)
+	DeleteUserSurveyResponses(ctx context.Context, userID int64) (uint32, error)
 }
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// DataKey - a user's data key, wrapped by the master key it names
+type DataKey struct {
+	UserID      int64     `bson:"userid"`
+	MasterKeyID string    `bson:"masterkeyid"`
+	WrappedKey  []byte    `bson:"wrappedkey"`
+	CreatedAt   time.Time `bson:"createdat"`
+}
+
+// KeyStore - interface for storing the wrapped data keys used to encrypt each user's health data
+type KeyStore interface {
+	// AddDataKey - store key unless the user already has one, returning the key the user ends up with
+	AddDataKey(ctx context.Context, key *DataKey) (*DataKey, error)
+	GetDataKey(ctx context.Context, userID int64) (*DataKey, error)
+	// GetDataKeysToRotate - up to limit keys wrapped by a master key other than the current one
+	GetDataKeysToRotate(ctx context.Context, currentMasterKeyID string, limit int) ([]*DataKey, error)
+	// RewrapDataKey - replace the user's stored key with key, as long as it is still wrapped by previousMasterKeyID
+	RewrapDataKey(ctx context.Context, key *DataKey, previousMasterKeyID string) error
+	DeleteDataKey(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	pbcommon "github.com/kic/health/pkg/proto/common"
+)
+
+// Date subdocuments are matched on their year, month and day fields rather than as a whole, as whole subdocuments
+// only compare equal, or in order, when every writer used the same field order.
+
+// dateEquals - match documents whose date field is date
+func dateEquals(field string, date *pbcommon.Date) bson.M {
+	return bson.M{field + ".year": date.Year, field + ".month": date.Month, field + ".day": date.Day}
+}
+
+// dateCompare - match documents whose date field compares to date as op, one of $gt, $gte, $lt or $lte, says
+func dateCompare(field string, op string, date *pbcommon.Date) bson.M {
+	strict := op
+	switch op {
+	case "$gte":
+		strict = "$gt"
+	case "$lte":
+		strict = "$lt"
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{field + ".year": bson.M{strict: date.Year}},
+		bson.M{field + ".year": date.Year, field + ".month": bson.M{strict: date.Month}},
+		bson.M{field + ".year": date.Year, field + ".month": date.Month, field + ".day": bson.M{op: date.Day}},
+	}}
+}
+
+// dateRange - match documents whose date field is from start to end, inclusive, either of which can be nil to
+// leave that side open
+func dateRange(field string, start *pbcommon.Date, end *pbcommon.Date) bson.M {
+	conditions := bson.A{}
+	if start != nil {
+		conditions = append(conditions, dateCompare(field, "$gte", start))
+	}
+	if end != nil {
+		conditions = append(conditions, dateCompare(field, "$lte", end))
+	}
+	if len(conditions) == 0 {
+		return bson.M{}
+	}
+	return bson.M{"$and": conditions}
+}
+
+// dateSort - the sort keys ordering documents by their date field
+func dateSort(field string, direction int) bson.D {
+	return bson.D{
+		{Key: field + ".year", Value: direction},
+		{Key: field + ".month", Value: direction},
+		{Key: field + ".day", Value: direction},
+	}
+}
+
+// withFilter - filter with the conditions of extra added, neither being changed
+func withFilter(filter bson.M, extra bson.M) bson.M {
+	if len(extra) == 0 {
+		return filter
+	}
+	return bson.M{"$and": bson.A{filter, extra}}
+}
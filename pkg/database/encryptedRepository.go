@@ -0,0 +1,433 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kic/health/pkg/encryption"
+	pbcommon "github.com/kic/health/pkg/proto/common"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// number of data keys read at a time while rotating
+const rotationBatchSize = 100
+
+// how long an unwrapped data key is used to decrypt before it is read from the key store again, so a key shredded
+// through another replica stops being used
+const dataKeyCacheTTL = time.Minute
+
+// EncryptedRepository - a Repository decorator that keeps journal text encrypted at rest. Each user's journals are
+// encrypted with their own data key, which is stored wrapped by the master key. Tags stay in plain text so the
+// database can aggregate and rename them. Every method not overridden here goes straight to the wrapped Repository.
+type EncryptedRepository struct {
+	Repository
+
+	keys    KeyStore
+	keyring *encryption.Keyring
+
+	// unwrapped data keys by user ID, so the master key is rarely used, and how many times each user's key was
+	// shredded, so a lookup that started before a shred does not cache the key again
+	dataKeys   map[int64]*cachedDataKey
+	shreds     map[int64]int64
+	dataKeysMu sync.Mutex
+
+	logger *zap.SugaredLogger
+}
+
+// cachedDataKey - an unwrapped data key along with the stored key it was unwrapped from
+type cachedDataKey struct {
+	key        []byte
+	wrappedKey []byte
+	expiresAt  time.Time
+}
+
+func NewEncryptedRepository(db Repository, keys KeyStore, keyring *encryption.Keyring, logger *zap.SugaredLogger) *EncryptedRepository {
+	return &EncryptedRepository{
+		Repository: db,
+		keys:       keys,
+		keyring:    keyring,
+		dataKeys:   make(map[int64]*cachedDataKey),
+		shreds:     make(map[int64]int64),
+		logger:     logger,
+	}
+}
+
+// dataKey - the unwrapped data key of the user, creating one if they have none and create is set. A user without
+// a key gets a nil key. Reads use a cached key until it expires, while writes check the key store first, so text is
+// never encrypted with a key shredded through another replica.
+func (e *EncryptedRepository) dataKey(ctx context.Context, userID int64, create bool) ([]byte, error) {
+	e.dataKeysMu.Lock()
+	cached, ok := e.dataKeys[userID]
+	shreds := e.shreds[userID]
+	e.dataKeysMu.Unlock()
+	if ok && !create && time.Now().Before(cached.expiresAt) {
+		return cached.key, nil
+	}
+
+	stored, err := e.keys.GetDataKey(ctx, userID)
+	if status.Code(err) == codes.NotFound {
+		if !create {
+			e.forgetDataKey(userID)
+			return nil, nil
+		}
+		stored, err = e.newDataKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var dataKey []byte
+	if ok && bytes.Equal(cached.wrappedKey, stored.WrappedKey) {
+		dataKey = cached.key
+	} else {
+		masterKey, ok := e.keyring.Get(stored.MasterKeyID)
+		if !ok {
+			e.logger.Errorf("Data key for user %v is wrapped by unknown master key %v", userID, stored.MasterKeyID)
+			return nil, errors.New("data key is wrapped by an unknown master key")
+		}
+
+		dataKey, err = masterKey.Unwrap(stored.WrappedKey, userID)
+		if err != nil {
+			e.logger.Errorf("Error unwrapping data key for user %v: %v", userID, err)
+			return nil, err
+		}
+	}
+
+	e.dataKeysMu.Lock()
+	// a key read before the user's data was shredded must not be cached again
+	if e.shreds[userID] == shreds {
+		e.dataKeys[userID] = &cachedDataKey{key: dataKey, wrappedKey: stored.WrappedKey, expiresAt: time.Now().Add(dataKeyCacheTTL)}
+	}
+	e.dataKeysMu.Unlock()
+
+	return dataKey, nil
+}
+
+// forgetDataKey - drop the user's cached data key
+func (e *EncryptedRepository) forgetDataKey(userID int64) {
+	e.dataKeysMu.Lock()
+	delete(e.dataKeys, userID)
+	e.dataKeysMu.Unlock()
+}
+
+// newDataKey - generate, wrap and store a data key for the user
+func (e *EncryptedRepository) newDataKey(ctx context.Context, userID int64) (*DataKey, error) {
+	dataKey, err := encryption.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := e.keyring.Current().Wrap(dataKey, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.keys.AddDataKey(ctx, &DataKey{
+		UserID:      userID,
+		MasterKeyID: e.keyring.Current().ID,
+		WrappedKey:  wrappedKey,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// encryptText - text encrypted with the user's data key, creating the key if they have none yet
+func (e *EncryptedRepository) encryptText(ctx context.Context, userID int64, text string) (string, error) {
+	if text == "" {
+		return text, nil
+	}
+
+	dataKey, err := e.dataKey(ctx, userID, true)
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := encryption.EncryptField(dataKey, text, userID)
+	if err != nil {
+		e.logger.Errorf("Error encrypting text for user %v: %v", userID, err)
+		return "", err
+	}
+
+	return encrypted, nil
+}
+
+// decryptText - text decrypted with the user's data key, unchanged if it was stored before encryption was enabled
+func (e *EncryptedRepository) decryptText(ctx context.Context, userID int64, text string) (string, error) {
+	if !encryption.IsEncrypted(text) {
+		return text, nil
+	}
+
+	dataKey, err := e.dataKey(ctx, userID, false)
+	if err != nil {
+		return "", err
+	}
+	if dataKey == nil {
+		e.logger.Errorf("No data key to decrypt text of user %v", userID)
+		return "", errors.New("no data key for encrypted text")
+	}
+
+	decrypted, err := encryption.DecryptField(dataKey, text, userID)
+	if err != nil {
+		e.logger.Errorf("Error decrypting text for user %v: %v", userID, err)
+		return "", err
+	}
+
+	return decrypted, nil
+}
+
+// encryptLog - a copy of healthLog with its journal encrypted for the user
+func (e *EncryptedRepository) encryptLog(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog) (*pbhealth.MentalHealthLog, error) {
+	toReturn := proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+
+	var err error
+	if toReturn.JournalName, err = e.encryptText(ctx, userID, healthLog.JournalName); err != nil {
+		return nil, err
+	}
+
+	return toReturn, nil
+}
+
+// decryptLog - a copy of healthLog with its journal decrypted
+func (e *EncryptedRepository) decryptLog(ctx context.Context, healthLog *pbhealth.MentalHealthLog) (*pbhealth.MentalHealthLog, error) {
+	toReturn := proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+
+	var err error
+	if toReturn.JournalName, err = e.decryptText(ctx, healthLog.UserID, healthLog.JournalName); err != nil {
+		return nil, err
+	}
+
+	return toReturn, nil
+}
+
+// decryptLogs - copies of logs with their journals decrypted
+func (e *EncryptedRepository) decryptLogs(ctx context.Context, logs []*pbhealth.MentalHealthLog) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0, len(logs))
+	for _, healthLog := range logs {
+		decrypted, err := e.decryptLog(ctx, healthLog)
+		if err != nil {
+			return nil, err
+		}
+		toReturn = append(toReturn, decrypted)
+	}
+
+	return toReturn, nil
+}
+
+func (e *EncryptedRepository) AddMentalHealthLog(ctx context.Context, healthLog *pbhealth.MentalHealthLog) (string, error) {
+	encrypted, err := e.encryptLog(ctx, healthLog.UserID, healthLog)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := e.Repository.AddMentalHealthLog(ctx, encrypted)
+	healthLog.Id = encrypted.Id
+	healthLog.Version = encrypted.Version
+
+	return id, err
+}
+
+func (e *EncryptedRepository) AddMentalHealthLogs(ctx context.Context, healthLogs []*pbhealth.MentalHealthLog) ([]string, error) {
+	encrypted := make([]*pbhealth.MentalHealthLog, 0, len(healthLogs))
+	for _, healthLog := range healthLogs {
+		encryptedLog, err := e.encryptLog(ctx, healthLog.UserID, healthLog)
+		if err != nil {
+			return nil, err
+		}
+		encrypted = append(encrypted, encryptedLog)
+	}
+
+	ids, err := e.Repository.AddMentalHealthLogs(ctx, encrypted)
+	for i, healthLog := range healthLogs {
+		healthLog.Id = encrypted[i].Id
+		healthLog.Version = encrypted[i].Version
+	}
+
+	return ids, err
+}
+
+func (e *EncryptedRepository) GetAllMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
+	logs, err := e.Repository.GetAllMentalHealthLogs(ctx, userID)
+	if err != nil {
+		return logs, err
+	}
+	return e.decryptLogs(ctx, logs)
+}
+
+func (e *EncryptedRepository) GetAllMentalHealthLogsByDate(ctx context.Context, userID int64, date *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
+	logs, err := e.Repository.GetAllMentalHealthLogsByDate(ctx, userID, date)
+	if err != nil {
+		return logs, err
+	}
+	return e.decryptLogs(ctx, logs)
+}
+
+func (e *EncryptedRepository) GetAllMentalHealthLogsInRange(ctx context.Context, userID int64, startDate *pbcommon.Date, endDate *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
+	logs, err := e.Repository.GetAllMentalHealthLogsInRange(ctx, userID, startDate, endDate)
+	if err != nil {
+		return logs, err
+	}
+	return e.decryptLogs(ctx, logs)
+}
+
+func (e *EncryptedRepository) GetMentalHealthLogsInRange(ctx context.Context, userID int64, query *LogRangeQuery) ([]*pbhealth.MentalHealthLog, string, error) {
+	logs, nextPageToken, err := e.Repository.GetMentalHealthLogsInRange(ctx, userID, query)
+	if err != nil {
+		return logs, nextPageToken, err
+	}
+	logs, err = e.decryptLogs(ctx, logs)
+	return logs, nextPageToken, err
+}
+
+func (e *EncryptedRepository) GetMentalHealthLogByID(ctx context.Context, userID int64, id string) (*pbhealth.MentalHealthLog, error) {
+	healthLog, err := e.Repository.GetMentalHealthLogByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return e.decryptLog(ctx, healthLog)
+}
+
+func (e *EncryptedRepository) GetDeletedMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
+	logs, err := e.Repository.GetDeletedMentalHealthLogs(ctx, userID)
+	if err != nil {
+		return logs, err
+	}
+	return e.decryptLogs(ctx, logs)
+}
+
+// withJournalText - ctx letting the wrapped repository read the user's journals, so the revisions it records only
+// list journalName when the text changed and not whenever the journal is encrypted again
+func (e *EncryptedRepository) withJournalText(ctx context.Context, userID int64) context.Context {
+	return withJournalText(ctx, func(journal string) (string, error) {
+		decrypted, err := e.decryptLog(ctx, &pbhealth.MentalHealthLog{UserID: userID, JournalName: journal})
+		if err != nil {
+			return "", err
+		}
+		return decrypted.JournalName, nil
+	})
+}
+
+func (e *EncryptedRepository) UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) error {
+	encrypted, err := e.encryptLog(ctx, userID, healthLog)
+	if err != nil {
+		return err
+	}
+	return e.Repository.UpdateMentalHealthLogs(e.withJournalText(ctx, userID), userID, encrypted, paths, expectedVersion)
+}
+
+func (e *EncryptedRepository) UpdateMentalHealthLogByID(ctx context.Context, userID int64, id string, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) (int64, error) {
+	encrypted, err := e.encryptLog(ctx, userID, healthLog)
+	if err != nil {
+		return 0, err
+	}
+	return e.Repository.UpdateMentalHealthLogByID(e.withJournalText(ctx, userID), userID, id, encrypted, paths, expectedVersion)
+}
+
+func (e *EncryptedRepository) RevertMentalHealthLogToRevision(ctx context.Context, userID int64, id string, revisionVersion int64, expectedVersion int64) (int64, error) {
+	return e.Repository.RevertMentalHealthLogToRevision(e.withJournalText(ctx, userID), userID, id, revisionVersion, expectedVersion)
+}
+
+func (e *EncryptedRepository) GetMentalHealthLogRevisions(ctx context.Context, userID int64, id string) ([]*pbhealth.HealthLogRevision, error) {
+	revisions, err := e.Repository.GetMentalHealthLogRevisions(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return e.decryptRevisions(ctx, revisions)
+}
+
+func (e *EncryptedRepository) GetRevisionsOfMentalHealthLogs(ctx context.Context, userID int64, ids []string) ([]*pbhealth.HealthLogRevision, error) {
+	revisions, err := e.Repository.GetRevisionsOfMentalHealthLogs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return e.decryptRevisions(ctx, revisions)
+}
+
+func (e *EncryptedRepository) decryptRevisions(ctx context.Context, revisions []*pbhealth.HealthLogRevision) ([]*pbhealth.HealthLogRevision, error) {
+	var err error
+	toReturn := make([]*pbhealth.HealthLogRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		decrypted := proto.Clone(revision).(*pbhealth.HealthLogRevision)
+		if decrypted.HealthLog, err = e.decryptLog(ctx, revision.HealthLog); err != nil {
+			return nil, err
+		}
+		toReturn = append(toReturn, decrypted)
+	}
+
+	return toReturn, nil
+}
+
+// RotateDataKeys - rewrap every data key still wrapped by an earlier master key with the current one, returning
+// how many keys were rewrapped. Journals stay encrypted with the same data keys, so they are readable throughout.
+func (e *EncryptedRepository) RotateDataKeys(ctx context.Context) (uint32, error) {
+	current := e.keyring.Current()
+	var numRotated uint32
+
+	for {
+		keys, err := e.keys.GetDataKeysToRotate(ctx, current.ID, rotationBatchSize)
+		if err != nil {
+			return numRotated, err
+		}
+
+		var batchRotated uint32
+		for _, key := range keys {
+			previous, ok := e.keyring.Get(key.MasterKeyID)
+			if !ok {
+				e.logger.Errorf("Cannot rotate data key for user %v wrapped by unknown master key %v", key.UserID, key.MasterKeyID)
+				continue
+			}
+
+			dataKey, err := previous.Unwrap(key.WrappedKey, key.UserID)
+			if err != nil {
+				e.logger.Errorf("Error unwrapping data key for user %v: %v", key.UserID, err)
+				continue
+			}
+
+			wrappedKey, err := current.Wrap(dataKey, key.UserID)
+			if err != nil {
+				return numRotated, err
+			}
+
+			err = e.keys.RewrapDataKey(ctx, &DataKey{
+				UserID:      key.UserID,
+				MasterKeyID: current.ID,
+				WrappedKey:  wrappedKey,
+				CreatedAt:   key.CreatedAt,
+			}, key.MasterKeyID)
+			if status.Code(err) == codes.Aborted {
+				// rotated or shredded by another write since it was read
+				continue
+			} else if err != nil {
+				return numRotated, err
+			}
+			batchRotated++
+		}
+
+		numRotated += batchRotated
+		// a batch that could not be rotated at all would be handed back again
+		if len(keys) < rotationBatchSize || batchRotated == 0 {
+			return numRotated, nil
+		}
+	}
+}
+
+// DeleteUserData - destroy the user's data key before removing their data, so any copy of their journals or free
+// text survey answers left in backups can no longer be decrypted
+func (e *EncryptedRepository) DeleteUserData(ctx context.Context, userID int64) (uint32, error) {
+	if err := e.keys.DeleteDataKey(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	e.dataKeysMu.Lock()
+	delete(e.dataKeys, userID)
+	e.shreds[userID]++
+	e.dataKeysMu.Unlock()
+
+	return e.Repository.DeleteUserData(ctx, userID)
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// EncryptedSurveyRepository - a SurveyRepository decorator that keeps free text answers encrypted at rest. They are
+// encrypted with the same per user data keys as journals, so shredding a user's data key through the
+// EncryptedRepository leaves their answers unreadable too. Every method not overridden here goes straight to the
+// wrapped SurveyRepository.
+type EncryptedSurveyRepository struct {
+	SurveyRepository
+
+	logs *EncryptedRepository
+}
+
+func NewEncryptedSurveyRepository(surveys SurveyRepository, logs *EncryptedRepository) *EncryptedSurveyRepository {
+	return &EncryptedSurveyRepository{
+		SurveyRepository: surveys,
+		logs:             logs,
+	}
+}
+
+func (e *EncryptedSurveyRepository) AddSurveyResponse(ctx context.Context, response *pbhealth.SurveyResponse) (string, error) {
+	encrypted := proto.Clone(response).(*pbhealth.SurveyResponse)
+	for _, answer := range encrypted.Answers {
+		var err error
+		if answer.Text, err = e.logs.encryptText(ctx, response.UserID, answer.Text); err != nil {
+			return "", err
+		}
+	}
+
+	id, err := e.SurveyRepository.AddSurveyResponse(ctx, encrypted)
+	response.Id = encrypted.Id
+
+	return id, err
+}
+
+func (e *EncryptedSurveyRepository) GetSurveyResponses(ctx context.Context, userID int64, surveyID string) ([]*pbhealth.SurveyResponse, error) {
+	responses, err := e.SurveyRepository.GetSurveyResponses(ctx, userID, surveyID)
+	if err != nil {
+		return responses, err
+	}
+
+	toReturn := make([]*pbhealth.SurveyResponse, 0, len(responses))
+	for _, response := range responses {
+		decrypted := proto.Clone(response).(*pbhealth.SurveyResponse)
+		for _, answer := range decrypted.Answers {
+			if answer.Text, err = e.logs.decryptText(ctx, response.UserID, answer.Text); err != nil {
+				return nil, err
+			}
+		}
+		toReturn = append(toReturn, decrypted)
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,20 @@
+package database
+
+import (
+	"time"
+)
+
+// IdempotencyRecord - a request made with a client supplied idempotency key, along with its response once it
+// has completed, kept so that retries of the request get the same response. A record in progress expires at the end
+// of a short lease, and a completed one at the end of the idempotency window. Token identifies the reservation, so
+// a request whose lease ran out cannot complete or release a record that a retry has since reserved.
+type IdempotencyRecord struct {
+	UserID      int64     `bson:"userid"`
+	Key         string    `bson:"key"`
+	Token       string    `bson:"token"`
+	Method      string    `bson:"method"`
+	RequestHash []byte    `bson:"requesthash"`
+	Response    []byte    `bson:"response"`
+	Completed   bool      `bson:"completed"`
+	ExpiresAt   time.Time `bson:"expiresat"`
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/pkg/logtime"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// revertPaths - the fields a revert copies back from a revision
+var revertPaths = []string{LogDatePath, ScorePath, JournalNamePath, DimensionScoresPath, TagsPath, LoggedAtPath, TimeZonePath, DateOnlyPath}
+
+// journalTextKey - the context key of the function reading the text of a stored journal, which EncryptedRepository
+// passes down since ciphertexts of the same text differ on every write
+type journalTextKey struct{}
+
+// withJournalText - ctx carrying journalText, for the revisions recorded under it to compare journals by their text
+func withJournalText(ctx context.Context, journalText func(journal string) (string, error)) context.Context {
+	return context.WithValue(ctx, journalTextKey{}, journalText)
+}
+
+// journalsEqual - whether two stored journals hold the same text
+func journalsEqual(ctx context.Context, a string, b string) bool {
+	if a == b {
+		return true
+	}
+	journalText, ok := ctx.Value(journalTextKey{}).(func(journal string) (string, error))
+	if !ok {
+		return false
+	}
+	aText, err := journalText(a)
+	if err != nil {
+		return false
+	}
+	bText, err := journalText(b)
+	return err == nil && aText == bText
+}
+
+// newRevision - the revision keeping previous as it was before update changes the fields named by paths, nil
+// when the update changes nothing
+func newRevision(ctx context.Context, previous *pbhealth.MentalHealthLog, update *pbhealth.MentalHealthLog, paths []string) *pbhealth.HealthLogRevision {
+	changedFields := make([]string, 0, len(paths))
+	for _, path := range paths {
+		changed := false
+		switch path {
+		case LogDatePath:
+			changed = !proto.Equal(previous.LogDate, update.LogDate)
+		case ScorePath:
+			changed = previous.Score != update.Score
+		case JournalNamePath:
+			changed = !journalsEqual(ctx, previous.JournalName, update.JournalName)
+		case DimensionScoresPath:
+			changed = !dimensionScoresEqual(previous.DimensionScores, update.DimensionScores)
+		case TagsPath:
+			changed = !tagsEqual(previous.Tags, update.Tags)
+		case LoggedAtPath:
+			changed = !proto.Equal(previous.LoggedAt, update.LoggedAt)
+		case TimeZonePath:
+			changed = previous.TimeZone != update.TimeZone
+		case DateOnlyPath:
+			changed = previous.DateOnly != update.DateOnly
+		}
+		if changed {
+			changedFields = append(changedFields, path)
+		}
+	}
+
+	if len(changedFields) == 0 {
+		return nil
+	}
+
+	return &pbhealth.HealthLogRevision{
+		LogID:         previous.Id,
+		UserID:        previous.UserID,
+		Version:       previous.Version,
+		RevisedAt:     timestamppb.Now(),
+		ChangedFields: changedFields,
+		HealthLog:     proto.Clone(previous).(*pbhealth.MentalHealthLog),
+	}
+}
+
+// revertedLog - the log a revert writes back from a revision. Revisions saved before logs had a time get the
+// start of their date, as the migration gives stored logs.
+func revertedLog(revision *pbhealth.HealthLogRevision) *pbhealth.MentalHealthLog {
+	healthLog := proto.Clone(revision.HealthLog).(*pbhealth.MentalHealthLog)
+	if healthLog.LoggedAt == nil {
+		logtime.Normalize(healthLog)
+	}
+	return healthLog
+}
+
+// dimensionScoresEqual - whether two lists of dimension scores rate the same dimensions in the same order
+func dimensionScoresEqual(a []*pbhealth.DimensionScore, b []*pbhealth.DimensionScore) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// tagsEqual - whether two lists of tags hold the same names in the same order
+func tagsEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// revisionNotFound - the error for a revert to a revision the log does not have
+func revisionNotFound() error {
+	return status.Errorf(codes.NotFound, "Health Log revision not found")
+}
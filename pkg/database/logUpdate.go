@@ -0,0 +1,90 @@
+package database
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// Field mask paths of the MentalHealthLog fields that can be updated
+const (
+	LogDatePath         = "logDate"
+	ScorePath           = "score"
+	JournalNamePath     = "journalName"
+	DimensionScoresPath = "dimensionScores"
+	TagsPath            = "tags"
+	LoggedAtPath        = "loggedAt"
+	TimeZonePath        = "timeZone"
+	DateOnlyPath        = "dateOnly"
+)
+
+// LogTimePaths - the paths that describe when a log was made, which only change together
+var LogTimePaths = []string{LogDatePath, LoggedAtPath, TimeZonePath, DateOnlyPath}
+
+// resolveUpdatePaths - check the requested field mask paths against the allowed ones, an empty mask means
+// every allowed path
+func resolveUpdatePaths(paths []string, allowed ...string) ([]string, error) {
+	if len(paths) == 0 {
+		return allowed, nil
+	}
+
+	for _, path := range paths {
+		found := false
+		for _, allowedPath := range allowed {
+			if path == allowedPath {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "Field %q cannot be updated", path)
+		}
+	}
+
+	return paths, nil
+}
+
+// applyLogUpdate - copy the fields named by paths from update onto healthLog
+func applyLogUpdate(healthLog *pbhealth.MentalHealthLog, update *pbhealth.MentalHealthLog, paths []string) {
+	for _, path := range paths {
+		switch path {
+		case LogDatePath:
+			healthLog.LogDate = update.LogDate
+		case ScorePath:
+			healthLog.Score = update.Score
+		case JournalNamePath:
+			healthLog.JournalName = update.JournalName
+		case DimensionScoresPath:
+			healthLog.DimensionScores = update.DimensionScores
+		case TagsPath:
+			healthLog.Tags = update.Tags
+		case LoggedAtPath:
+			healthLog.LoggedAt = update.LoggedAt
+		case TimeZonePath:
+			healthLog.TimeZone = update.TimeZone
+		case DateOnlyPath:
+			healthLog.DateOnly = update.DateOnly
+		}
+	}
+}
+
+// hasPath - whether paths contains path
+func hasPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// versionMatches - whether a log at version satisfies the version a write expects, 0 accepting any version
+func versionMatches(version int64, expectedVersion int64) bool {
+	return expectedVersion == 0 || version == expectedVersion
+}
+
+// versionConflict - the error for a write whose expected version no longer matches the stored log
+func versionConflict() error {
+	return status.Errorf(codes.Aborted, "Health Log was changed by another write")
+}
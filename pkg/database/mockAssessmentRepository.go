@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kic/health/pkg/date"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+type MockAssessmentRepository struct {
+	assessments map[int]*pbhealth.Assessment
+
+	idCounter int
+
+	logger *zap.SugaredLogger
+}
+
+func NewMockAssessmentRepository(logger *zap.SugaredLogger) *MockAssessmentRepository {
+	return &MockAssessmentRepository{
+		assessments: make(map[int]*pbhealth.Assessment),
+		logger:      logger,
+	}
+}
+
+func (m *MockAssessmentRepository) AddAssessment(ctx context.Context, assessment *pbhealth.Assessment) (string, error) {
+	if assessment.UserID < 0 || assessment.AssessmentDate == nil {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid Argument for AddAssessment")
+	}
+	assessment.Id = fmt.Sprint(m.idCounter)
+	m.assessments[m.idCounter] = assessment
+	m.idCounter++
+
+	return assessment.Id, nil
+}
+
+func (m *MockAssessmentRepository) GetAssessments(ctx context.Context, userID int64, query *AssessmentQuery) ([]*pbhealth.Assessment, error) {
+	toReturn := make([]*pbhealth.Assessment, 0)
+
+	for _, val := range m.assessments {
+		if val.UserID == userID && assessmentMatches(val, query) {
+			toReturn = append(toReturn, val)
+		}
+	}
+
+	sortAssessments(toReturn)
+
+	return toReturn, nil
+}
+
+func (m *MockAssessmentRepository) DeleteUserAssessments(ctx context.Context, userID int64) (uint32, error) {
+	var numDeleted uint32
+	for key, val := range m.assessments {
+		if val.UserID == userID {
+			delete(m.assessments, key)
+			numDeleted++
+		}
+	}
+
+	return numDeleted, nil
+}
+
+func assessmentMatches(assessment *pbhealth.Assessment, query *AssessmentQuery) bool {
+	if len(query.Types) > 0 {
+		found := false
+		for _, assessmentType := range query.Types {
+			if assessment.Type == assessmentType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if query.StartDate != nil && date.Compare(assessment.AssessmentDate, query.StartDate) < 0 {
+		return false
+	}
+	if query.EndDate != nil && date.Compare(assessment.AssessmentDate, query.EndDate) > 0 {
+		return false
+	}
+	return true
+}
+
+// sortAssessments - order assessments oldest first, by date and then by when they were submitted
+func sortAssessments(assessments []*pbhealth.Assessment) {
+	sort.SliceStable(assessments, func(i, j int) bool {
+		if c := date.Compare(assessments[i].AssessmentDate, assessments[j].AssessmentDate); c != 0 {
+			return c < 0
+		}
+		return assessments[i].SubmittedAt.AsTime().Before(assessments[j].SubmittedAt.AsTime())
+	})
+}
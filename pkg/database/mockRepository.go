@@ -6,45 +6,143 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"math"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/kic/health/pkg/date"
 	pbcommon "github.com/kic/health/pkg/proto/common"
 	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/tag"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MockRepository struct {
 	logCollection map[int]*pbhealth.MentalHealthLog
 
+	scoreAggregates map[int64]*ScoreAggregate
+
+	idempotencyRecords map[string]*IdempotencyRecord
+
+	revisions map[int][]*pbhealth.HealthLogRevision
+
+	accessGrants map[string]*pbhealth.AccessGrant
+
+	dataKeys map[int64]*DataKey
+
 	idCounter int
 
 	logger *zap.SugaredLogger
 }
 
 func NewMockRepository(logCollection map[int]*pbhealth.MentalHealthLog, logger *zap.SugaredLogger) *MockRepository {
-	return &MockRepository{
-		logCollection: logCollection,
-		idCounter:     len(logCollection),
-		logger:        logger,
+	m := &MockRepository{
+		logCollection:      logCollection,
+		scoreAggregates:    make(map[int64]*ScoreAggregate),
+		idempotencyRecords: make(map[string]*IdempotencyRecord),
+		revisions:          make(map[int][]*pbhealth.HealthLogRevision),
+		accessGrants:       make(map[string]*pbhealth.AccessGrant),
+		dataKeys:           make(map[int64]*DataKey),
+		idCounter:          len(logCollection),
+		logger:             logger,
+	}
+	for key, val := range logCollection {
+		val.Id = fmt.Sprint(key)
+		if key >= m.idCounter {
+			m.idCounter = key + 1
+		}
+	}
+	m.RebuildScoreAggregates(context.Background(), nil)
+	return m
+}
+
+// findByID - the key and log with the given ID belonging to the given user
+func (m *MockRepository) findByID(userID int64, id string) (int, *pbhealth.MentalHealthLog, error) {
+	key, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.NotFound, "Health Log not found")
 	}
+
+	val, ok := m.logCollection[key]
+	if !ok || val.UserID != userID || val.DeletedAt != nil {
+		return 0, nil, status.Errorf(codes.NotFound, "Health Log not found")
+	}
+
+	return key, val, nil
+}
+
+// trash - soft delete a log, taking it out of every read and of the user's running totals
+func (m *MockRepository) trash(val *pbhealth.MentalHealthLog) {
+	val.DeletedAt = timestamppb.Now()
+	val.Version++
+	m.adjustAggregate(val.UserID, aggregateDelta(nil, []*pbhealth.MentalHealthLog{val}))
+}
+
+// adjustAggregate - apply the change in score totals and log counts from a write to the user's running totals
+func (m *MockRepository) adjustAggregate(userID int64, delta *ScoreAggregate) {
+	if delta.isZero() {
+		return
+	}
+
+	aggregate, ok := m.scoreAggregates[userID]
+	if !ok {
+		aggregate = &ScoreAggregate{UserID: userID}
+		m.scoreAggregates[userID] = aggregate
+	}
+	aggregate.Sum += delta.Sum
+	aggregate.Count += delta.Count
+	for dimension, totals := range delta.Dimensions {
+		if aggregate.Dimensions == nil {
+			aggregate.Dimensions = make(map[string]*DimensionAggregate)
+		}
+		if aggregate.Dimensions[dimension] == nil {
+			aggregate.Dimensions[dimension] = &DimensionAggregate{}
+		}
+		aggregate.Dimensions[dimension].Sum += totals.Sum
+		aggregate.Dimensions[dimension].Count += totals.Count
+	}
+	aggregate.LastUpdated = time.Now()
 }
 
 func (m *MockRepository) AddMentalHealthLog(ctx context.Context, healthLog *pbhealth.MentalHealthLog) (string, error) {
 	if healthLog.UserID < 0 || healthLog.LogDate == nil {
 		return "", status.Errorf(codes.InvalidArgument, "Invalid Argument for AddMentalHealthLog")
 	}
+	healthLog.Version = 1
 	m.logCollection[m.idCounter] = healthLog
+	m.adjustAggregate(healthLog.UserID, aggregateDelta([]*pbhealth.MentalHealthLog{healthLog}, nil))
 	var toReturn string
 	toReturn = fmt.Sprint(m.idCounter)
+	healthLog.Id = toReturn
 	m.idCounter++
 
 	return toReturn, nil
 }
 
+func (m *MockRepository) AddMentalHealthLogs(ctx context.Context, healthLogs []*pbhealth.MentalHealthLog) ([]string, error) {
+	for _, healthLog := range healthLogs {
+		if healthLog.UserID < 0 || healthLog.LogDate == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid Argument for AddMentalHealthLogs")
+		}
+	}
+
+	toReturn := make([]string, 0, len(healthLogs))
+	for _, healthLog := range healthLogs {
+		id, err := m.AddMentalHealthLog(ctx, healthLog)
+		if err != nil {
+			return toReturn, err
+		}
+		toReturn = append(toReturn, id)
+	}
+
+	return toReturn, nil
+}
+
 func (m *MockRepository) GetAllMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
 	toReturn := make([]*pbhealth.MentalHealthLog, 0)
 
 	for _, val := range m.logCollection {
-		if val.UserID == userID {
+		if val.UserID == userID && val.DeletedAt == nil {
 			toReturn = append(toReturn, val)
 		}
 	}
@@ -56,12 +154,12 @@ func (m *MockRepository) GetAllMentalHealthLogs(ctx context.Context, userID int6
 	return toReturn, nil
 }
 
-func (m *MockRepository) GetAllMentalHealthLogsByDate(ctx context.Context, userID int64, date *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
+func (m *MockRepository) GetAllMentalHealthLogsByDate(ctx context.Context, userID int64, logDate *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
 
 	toReturn := make([]*pbhealth.MentalHealthLog, 0)
 
 	for _, val := range m.logCollection {
-		if val.UserID == userID && val.LogDate.Year == date.Year && val.LogDate.Month == date.Month && val.LogDate.Day == date.Day {
+		if val.UserID == userID && val.DeletedAt == nil && date.Equal(val.LogDate, logDate) {
 			toReturn = append(toReturn, val)
 		}
 	}
@@ -73,24 +171,114 @@ func (m *MockRepository) GetAllMentalHealthLogsByDate(ctx context.Context, userI
 	return toReturn, nil
 }
 
-func (m *MockRepository) DeleteMentalHealthLogs(ctx context.Context, userID int64, date *pbcommon.Date, all bool) (uint32, error) {
+func (m *MockRepository) GetAllMentalHealthLogsInRange(ctx context.Context, userID int64, startDate *pbcommon.Date, endDate *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
+	if userID < 0 || startDate == nil || endDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Argument for GetAllMentalHealthLogsInRange")
+	}
+
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	for _, val := range m.logCollection {
+		if val.UserID == userID && val.DeletedAt == nil && date.Compare(val.LogDate, startDate) >= 0 && date.Compare(val.LogDate, endDate) <= 0 {
+			toReturn = append(toReturn, val)
+		}
+	}
+
+	return toReturn, nil
+}
+
+func (m *MockRepository) GetMentalHealthLogsInRange(ctx context.Context, userID int64, query *LogRangeQuery) ([]*pbhealth.MentalHealthLog, string, error) {
+	if userID < 0 || query.StartDate == nil || query.EndDate == nil || query.PageSize <= 0 {
+		return nil, "", status.Errorf(codes.InvalidArgument, "Invalid Argument for GetMentalHealthLogsInRange")
+	}
+
+	cursor, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys := make([]int, 0)
+
+	for key, val := range m.logCollection {
+		if val.UserID == userID && (val.DeletedAt != nil) == query.Deleted && date.Compare(val.LogDate, query.StartDate) >= 0 && date.Compare(val.LogDate, query.EndDate) <= 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	// position of a log relative to another in ascending order, comparing date and then ID
+	compareLogs := func(logDate *pbcommon.Date, key int, otherDate *pbcommon.Date, otherKey int) int {
+		if cmp := date.Compare(logDate, otherDate); cmp != 0 {
+			return cmp
+		}
+		switch {
+		case key < otherKey:
+			return -1
+		case key > otherKey:
+			return 1
+		}
+		return 0
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		cmp := compareLogs(m.logCollection[keys[i]].LogDate, keys[i], m.logCollection[keys[j]].LogDate, keys[j])
+		if query.Descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	if cursor != nil {
+		cursorKey, err := strconv.Atoi(cursor.ID)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "Invalid page token")
+		}
+
+		start := sort.Search(len(keys), func(i int) bool {
+			cmp := compareLogs(m.logCollection[keys[i]].LogDate, keys[i], cursor.date(), cursorKey)
+			if query.Descending {
+				return cmp < 0
+			}
+			return cmp > 0
+		})
+		keys = keys[start:]
+	}
+
+	var nextPageToken string
+	if len(keys) > query.PageSize {
+		keys = keys[:query.PageSize]
+		last := keys[len(keys)-1]
+		nextPageToken = encodePageToken(m.logCollection[last].LogDate, fmt.Sprint(last))
+	}
+
+	toReturn := make([]*pbhealth.MentalHealthLog, 0, len(keys))
+	for _, key := range keys {
+		toReturn = append(toReturn, m.logCollection[key])
+	}
+
+	return toReturn, nextPageToken, nil
+}
+
+func (m *MockRepository) DeleteMentalHealthLogs(ctx context.Context, userID int64, logDate *pbcommon.Date, all bool) (uint32, error) {
 
-	if userID < 0 || (date == nil && all == false) {
+	if userID < 0 || (logDate == nil && all == false) {
 		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for DeleteMentalHealthLog")
 	}
 
 	var numDeleted uint32
 	numDeleted = 0
 
-	for key, val := range m.logCollection {
+	for _, val := range m.logCollection {
+		if val.DeletedAt != nil {
+			continue
+		}
 		if all == false {
-			if val.UserID == userID && val.LogDate.Year == date.Year && val.LogDate.Month == date.Month && val.LogDate.Day == date.Day {
-				delete(m.logCollection, key)
+			if val.UserID == userID && date.Equal(val.LogDate, logDate) {
+				m.trash(val)
 				numDeleted++
 			}
 		} else {
 			if val.UserID == userID {
-				delete(m.logCollection, key)
+				m.trash(val)
 				numDeleted++
 			}
 		}
@@ -100,48 +288,461 @@ func (m *MockRepository) DeleteMentalHealthLogs(ctx context.Context, userID int6
 	return numDeleted, nil
 }
 
-func (m *MockRepository) UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog) error {
+func (m *MockRepository) UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) error {
 	if userID < 0 || healthLog.LogDate == nil {
 		return status.Errorf(codes.InvalidArgument, "Invalid Argument for UpdateMentalHealthLog")
 	}
 
-	for _, val := range m.logCollection {
-		if val.UserID == userID && val.LogDate.Year == healthLog.LogDate.Year && val.LogDate.Month == healthLog.LogDate.Month && val.LogDate.Day == healthLog.LogDate.Day {
-			val.Score = healthLog.Score
-			val.JournalName = healthLog.JournalName
+	paths, err := resolveUpdatePaths(paths, ScorePath, JournalNamePath, DimensionScoresPath, TagsPath)
+	if err != nil {
+		return err
+	}
+
+	toUpdate := make(map[int]*pbhealth.MentalHealthLog)
+	for key, val := range m.logCollection {
+		if val.UserID == userID && val.DeletedAt == nil && date.Equal(val.LogDate, healthLog.LogDate) {
+			if !versionMatches(val.Version, expectedVersion) {
+				return versionConflict()
+			}
+			toUpdate[key] = val
 		}
 	}
 
+	for key, val := range toUpdate {
+		m.updateLog(ctx, key, val, healthLog, paths)
+	}
+
 	return nil
 }
 
-func (m *MockRepository) GetOverallScore(ctx context.Context, userID int64) (int32, error) {
-	logs, err := m.GetAllMentalHealthLogs(ctx, userID)
-	m.logger.Infof("Logs fetched for user (ID = %v\n): %v\n", userID, logs)
+func (m *MockRepository) GetMentalHealthLogByID(ctx context.Context, userID int64, id string) (*pbhealth.MentalHealthLog, error) {
+	_, val, err := m.findByID(userID, id)
+	return val, err
+}
+
+func (m *MockRepository) UpdateMentalHealthLogByID(ctx context.Context, userID int64, id string, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) (int64, error) {
+	paths, err := resolveUpdatePaths(paths, LogDatePath, ScorePath, JournalNamePath, DimensionScoresPath, TagsPath, LoggedAtPath, TimeZonePath, DateOnlyPath)
+	if err != nil {
+		return 0, err
+	}
+
+	if hasPath(paths, LogDatePath) && healthLog.LogDate == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for UpdateMentalHealthLogByID")
+	}
+
+	key, val, err := m.findByID(userID, id)
+	if err != nil {
+		return 0, err
+	}
+
+	if !versionMatches(val.Version, expectedVersion) {
+		return 0, versionConflict()
+	}
+
+	m.updateLog(ctx, key, val, healthLog, paths)
+
+	return val.Version, nil
+}
+
+// updateLog - apply an update to the stored log under key, keeping what it replaces as a revision
+func (m *MockRepository) updateLog(ctx context.Context, key int, val *pbhealth.MentalHealthLog, update *pbhealth.MentalHealthLog, paths []string) {
+	if revision := newRevision(ctx, val, update, paths); revision != nil {
+		m.revisions[key] = append(m.revisions[key], revision)
+	}
+
+	m.adjustAggregate(val.UserID, updateDelta([]*pbhealth.MentalHealthLog{val}, update, paths))
+	applyLogUpdate(val, update, paths)
+	val.Version++
+}
+
+func (m *MockRepository) GetMentalHealthLogRevisions(ctx context.Context, userID int64, id string) ([]*pbhealth.HealthLogRevision, error) {
+	key, _, err := m.findByID(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := m.revisions[key]
+	toReturn := make([]*pbhealth.HealthLogRevision, 0, len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		toReturn = append(toReturn, revisions[i])
+	}
+
+	return toReturn, nil
+}
+
+func (m *MockRepository) GetRevisionsOfMentalHealthLogs(ctx context.Context, userID int64, ids []string) ([]*pbhealth.HealthLogRevision, error) {
+	toReturn := make([]*pbhealth.HealthLogRevision, 0)
+
+	for _, id := range ids {
+		key, err := strconv.Atoi(id)
+		if err != nil {
+			continue
+		}
+		if val, ok := m.logCollection[key]; !ok || val.UserID != userID {
+			continue
+		}
+		revisions := m.revisions[key]
+		for i := len(revisions) - 1; i >= 0; i-- {
+			toReturn = append(toReturn, revisions[i])
+		}
+	}
 
+	return toReturn, nil
+}
+
+func (m *MockRepository) RevertMentalHealthLogToRevision(ctx context.Context, userID int64, id string, revisionVersion int64, expectedVersion int64) (int64, error) {
+	key, _, err := m.findByID(userID, id)
 	if err != nil {
-		m.logger.Errorf("cannot get mental health logs for user: %v \n", err)
+		return 0, err
+	}
+
+	for _, revision := range m.revisions[key] {
+		if revision.Version == revisionVersion {
+			return m.UpdateMentalHealthLogByID(ctx, userID, id, revertedLog(revision), revertPaths, expectedVersion)
+		}
+	}
+
+	return 0, revisionNotFound()
+}
+
+func (m *MockRepository) DeleteMentalHealthLogByID(ctx context.Context, userID int64, id string, expectedVersion int64) error {
+	_, val, err := m.findByID(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !versionMatches(val.Version, expectedVersion) {
+		return versionConflict()
+	}
+
+	m.trash(val)
+
+	return nil
+}
+
+func (m *MockRepository) GetOverallScore(ctx context.Context, userID int64) (int32, error) {
+	aggregate := m.scoreAggregates[userID]
+	overallScore := aggregate.Average()
+
+	m.logger.Infof("Average score for user (ID = %v): %v\n", userID, overallScore)
+
+	return overallScore, nil
+}
+
+func (m *MockRepository) GetDimensionScore(ctx context.Context, userID int64, dimension string) (int32, bool, error) {
+	aggregate := m.scoreAggregates[userID]
+	dimensionScore, rated := aggregate.DimensionAverage(dimension)
+
+	m.logger.Infof("Average %v score for user (ID = %v): %v\n", dimension, userID, dimensionScore)
+
+	return dimensionScore, rated, nil
+}
+
+func (m *MockRepository) RebuildScoreAggregates(ctx context.Context, userIDs []int64) (uint32, error) {
+	rebuilt := make(map[int64]*ScoreAggregate)
+	inScope := func(userID int64) bool {
+		if len(userIDs) == 0 {
+			return true
+		}
+		for _, id := range userIDs {
+			if id == userID {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, val := range m.logCollection {
+		if !inScope(val.UserID) || val.DeletedAt != nil {
+			continue
+		}
+		aggregate, ok := rebuilt[val.UserID]
+		if !ok {
+			aggregate = &ScoreAggregate{UserID: val.UserID, LastUpdated: time.Now()}
+			rebuilt[val.UserID] = aggregate
+		}
+		aggregate.add(val, 1)
+	}
+
+	for userID := range m.scoreAggregates {
+		if inScope(userID) {
+			delete(m.scoreAggregates, userID)
+		}
+	}
+	for userID, aggregate := range rebuilt {
+		m.scoreAggregates[userID] = aggregate
+	}
+
+	return uint32(len(rebuilt)), nil
+}
+
+func idempotencyRecordKey(userID int64, key string) string {
+	return fmt.Sprintf("%v/%v", userID, key)
+}
+
+func (m *MockRepository) ReserveIdempotencyKey(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, error) {
+	recordKey := idempotencyRecordKey(record.UserID, record.Key)
+
+	existing, ok := m.idempotencyRecords[recordKey]
+	if ok && existing.ExpiresAt.After(time.Now()) {
+		return existing, nil
+	}
+
+	m.idempotencyRecords[recordKey] = record
+	return nil, nil
+}
+
+func (m *MockRepository) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, token string, response []byte, expiresAt time.Time) error {
+	record, ok := m.idempotencyRecords[idempotencyRecordKey(userID, key)]
+	if !ok || record.Token != token {
+		return status.Errorf(codes.NotFound, "Idempotency key reservation not found")
+	}
+
+	record.Response = response
+	record.Completed = true
+	record.ExpiresAt = expiresAt
+	return nil
+}
+
+func (m *MockRepository) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string, token string) error {
+	recordKey := idempotencyRecordKey(userID, key)
+	if record, ok := m.idempotencyRecords[recordKey]; ok && record.Token == token {
+		delete(m.idempotencyRecords, recordKey)
+	}
+	return nil
+}
+
+func (m *MockRepository) GetDeletedMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	for _, val := range m.logCollection {
+		if val.UserID == userID && val.DeletedAt != nil {
+			toReturn = append(toReturn, val)
+		}
+	}
+
+	sort.Slice(toReturn, func(i, j int) bool {
+		return toReturn[i].DeletedAt.AsTime().After(toReturn[j].DeletedAt.AsTime())
+	})
+
+	return toReturn, nil
+}
+
+func (m *MockRepository) RestoreMentalHealthLogs(ctx context.Context, userID int64, ids []string, all bool) (uint32, error) {
+	if userID < 0 || (len(ids) == 0 && all == false) {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for RestoreMentalHealthLogs")
+	}
+
+	toRestore := make(map[string]bool)
+	for _, id := range ids {
+		toRestore[id] = true
+	}
+
+	var numRestored uint32
+	for _, val := range m.logCollection {
+		if val.UserID == userID && val.DeletedAt != nil && (all || toRestore[val.Id]) {
+			val.DeletedAt = nil
+			val.Version++
+			m.adjustAggregate(userID, aggregateDelta([]*pbhealth.MentalHealthLog{val}, nil))
+			numRestored++
+		}
+	}
+
+	return numRestored, nil
+}
+
+func (m *MockRepository) PurgeDeletedMentalHealthLogs(ctx context.Context, deletedBefore time.Time) (uint32, error) {
+	var numPurged uint32
+	for key, val := range m.logCollection {
+		if val.DeletedAt != nil && val.DeletedAt.AsTime().Before(deletedBefore) {
+			delete(m.logCollection, key)
+			delete(m.revisions, key)
+			numPurged++
+		}
+	}
+
+	return numPurged, nil
+}
+
+func (m *MockRepository) SaveAccessGrant(ctx context.Context, grant *pbhealth.AccessGrant) error {
+	m.accessGrants[accessGrantKey(grant.OwnerID, grant.GranteeID)] = grant
+	return nil
+}
+
+func (m *MockRepository) DeleteAccessGrant(ctx context.Context, ownerID int64, granteeID int64) error {
+	key := accessGrantKey(ownerID, granteeID)
+	if _, ok := m.accessGrants[key]; !ok {
+		return status.Errorf(codes.NotFound, "Access grant not found")
+	}
+
+	delete(m.accessGrants, key)
+	return nil
+}
+
+func (m *MockRepository) GetAccessGrant(ctx context.Context, ownerID int64, granteeID int64) (*pbhealth.AccessGrant, error) {
+	grant, ok := m.accessGrants[accessGrantKey(ownerID, granteeID)]
+	if !ok || !grantActive(grant, time.Now()) {
+		return nil, status.Errorf(codes.NotFound, "Access grant not found")
+	}
+
+	return grant, nil
+}
+
+func (m *MockRepository) GetAccessGrantsByOwner(ctx context.Context, ownerID int64) ([]*pbhealth.AccessGrant, error) {
+	return m.findAccessGrants(func(grant *pbhealth.AccessGrant) bool {
+		return grant.OwnerID == ownerID
+	}), nil
+}
+
+func (m *MockRepository) GetAccessGrantsByGrantee(ctx context.Context, granteeID int64) ([]*pbhealth.AccessGrant, error) {
+	return m.findAccessGrants(func(grant *pbhealth.AccessGrant) bool {
+		return grant.GranteeID == granteeID
+	}), nil
+}
+
+// findAccessGrants - the unexpired grants matching filter, soonest to expire first
+func (m *MockRepository) findAccessGrants(filter func(*pbhealth.AccessGrant) bool) []*pbhealth.AccessGrant {
+	toReturn := make([]*pbhealth.AccessGrant, 0)
+	now := time.Now()
+
+	for _, grant := range m.accessGrants {
+		if filter(grant) && grantActive(grant, now) {
+			toReturn = append(toReturn, grant)
+		}
+	}
+
+	sort.Slice(toReturn, func(i, j int) bool {
+		return toReturn[i].ExpiresAt.AsTime().Before(toReturn[j].ExpiresAt.AsTime())
+	})
+
+	return toReturn
+}
+
+func (m *MockRepository) AddDataKey(ctx context.Context, key *DataKey) (*DataKey, error) {
+	if existing, ok := m.dataKeys[key.UserID]; ok {
+		return existing, nil
+	}
+
+	m.dataKeys[key.UserID] = key
+	return key, nil
+}
+
+func (m *MockRepository) GetDataKey(ctx context.Context, userID int64) (*DataKey, error) {
+	key, ok := m.dataKeys[userID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Data key not found")
+	}
+
+	return key, nil
+}
+
+func (m *MockRepository) GetDataKeysToRotate(ctx context.Context, currentMasterKeyID string, limit int) ([]*DataKey, error) {
+	toReturn := make([]*DataKey, 0)
+	for _, key := range m.dataKeys {
+		if len(toReturn) == limit {
+			break
+		}
+		if key.MasterKeyID != currentMasterKeyID {
+			toReturn = append(toReturn, key)
+		}
+	}
+
+	return toReturn, nil
+}
+
+func (m *MockRepository) RewrapDataKey(ctx context.Context, key *DataKey, previousMasterKeyID string) error {
+	existing, ok := m.dataKeys[key.UserID]
+	if !ok || existing.MasterKeyID != previousMasterKeyID {
+		return status.Errorf(codes.Aborted, "Data key was changed by another write")
+	}
+
+	m.dataKeys[key.UserID] = key
+	return nil
+}
+
+func (m *MockRepository) DeleteDataKey(ctx context.Context, userID int64) error {
+	delete(m.dataKeys, userID)
+	return nil
+}
+
+func (m *MockRepository) MigrateLogTimes(ctx context.Context, location *time.Location) (uint32, error) {
+	var numMigrated uint32
+	for _, val := range m.logCollection {
+		if val.LoggedAt != nil || val.LogDate == nil {
+			continue
+		}
+		val.LoggedAt = timestamppb.New(date.ToTime(val.LogDate, location))
+		val.TimeZone = location.String()
+		val.DateOnly = true
+		numMigrated++
 	}
-	var totalScore float64
-	totalScore = 0
 
-	numLogs := 0
+	m.logger.Infof("Migrated %v mental health logs to %v\n", numMigrated, location)
+
+	return numMigrated, nil
+}
 
-	for _, log := range logs {
-		totalScore += float64(log.Score)
-		numLogs++
+func (m *MockRepository) GetTagUsage(ctx context.Context, userID int64) ([]*pbhealth.TagUsage, error) {
+	usage := make(map[string]*pbhealth.TagUsage)
+	for _, val := range m.logCollection {
+		if val.UserID != userID || val.DeletedAt != nil {
+			continue
+		}
+		for _, name := range val.Tags {
+			tagUsage, ok := usage[name]
+			if !ok {
+				tagUsage = &pbhealth.TagUsage{Name: name}
+				usage[name] = tagUsage
+			}
+			tagUsage.Count++
+			if val.LogDate != nil && (tagUsage.LastUsed == nil || date.Compare(val.LogDate, tagUsage.LastUsed) > 0) {
+				tagUsage.LastUsed = val.LogDate
+			}
+		}
 	}
 
-	var overallScore int32
-	if numLogs == 0 {
-		overallScore = 0
-	} else {
-		overallScore = int32(math.Round(totalScore / float64(numLogs)))
+	toReturn := make([]*pbhealth.TagUsage, 0, len(usage))
+	for _, tagUsage := range usage {
+		toReturn = append(toReturn, tagUsage)
 	}
+	sortTagUsage(toReturn)
 
-	m.logger.Infof("Total sum of log scores for user (ID = %v\n): %v\n", userID, totalScore)
-	m.logger.Infof("Number of total logs for user (ID = %v): %v\n:", userID, numLogs)
-	m.logger.Infof("Average score for user (ID = %v): %v\n:", userID, overallScore)
+	return toReturn, nil
+}
 
-	return overallScore, err
-}
\ No newline at end of file
+func (m *MockRepository) ReplaceTags(ctx context.Context, userID int64, sources []string, target string) (uint32, error) {
+	var numUpdated uint32
+	for key, val := range m.logCollection {
+		if val.UserID != userID {
+			continue
+		}
+		if tags, changed := tag.Replace(val.Tags, sources, target); changed {
+			m.updateLog(ctx, key, val, &pbhealth.MentalHealthLog{Tags: tags}, []string{TagsPath})
+			numUpdated++
+		}
+	}
+
+	m.logger.Infof("Replaced tags on %v mental health logs for user (ID = %v)\n", numUpdated, userID)
+
+	return numUpdated, nil
+}
+
+func (m *MockRepository) DeleteUserData(ctx context.Context, userID int64) (uint32, error) {
+	var numDeleted uint32
+	for key, val := range m.logCollection {
+		if val.UserID == userID {
+			delete(m.logCollection, key)
+			delete(m.revisions, key)
+			numDeleted++
+		}
+	}
+
+	delete(m.scoreAggregates, userID)
+	for key, grant := range m.accessGrants {
+		if grant.OwnerID == userID || grant.GranteeID == userID {
+			delete(m.accessGrants, key)
+		}
+	}
+
+	return numDeleted, nil
+}
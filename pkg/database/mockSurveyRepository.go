@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+type MockSurveyRepository struct {
+	// every version of each survey, oldest first
+	surveys map[string][]*pbhealth.Survey
+
+	responses map[int]*pbhealth.SurveyResponse
+
+	idCounter int
+
+	logger *zap.SugaredLogger
+}
+
+func NewMockSurveyRepository(logger *zap.SugaredLogger) *MockSurveyRepository {
+	return &MockSurveyRepository{
+		surveys:   make(map[string][]*pbhealth.Survey),
+		responses: make(map[int]*pbhealth.SurveyResponse),
+		logger:    logger,
+	}
+}
+
+func (m *MockSurveyRepository) PublishSurvey(ctx context.Context, survey *pbhealth.Survey) (int64, error) {
+	if survey.Id == "" {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for PublishSurvey")
+	}
+	survey.Version = int64(len(m.surveys[survey.Id]) + 1)
+	m.surveys[survey.Id] = append(m.surveys[survey.Id], survey)
+
+	return survey.Version, nil
+}
+
+func (m *MockSurveyRepository) GetSurvey(ctx context.Context, id string, version int64) (*pbhealth.Survey, error) {
+	versions := m.surveys[id]
+	if version == 0 {
+		version = int64(len(versions))
+	}
+	if version < 1 || version > int64(len(versions)) {
+		return nil, surveyNotFound()
+	}
+
+	return versions[version-1], nil
+}
+
+func (m *MockSurveyRepository) AddSurveyResponse(ctx context.Context, response *pbhealth.SurveyResponse) (string, error) {
+	if response.UserID < 0 || response.SurveyID == "" {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid Argument for AddSurveyResponse")
+	}
+	response.Id = fmt.Sprint(m.idCounter)
+	m.responses[m.idCounter] = response
+	m.idCounter++
+
+	return response.Id, nil
+}
+
+func (m *MockSurveyRepository) GetSurveyResponses(ctx context.Context, userID int64, surveyID string) ([]*pbhealth.SurveyResponse, error) {
+	toReturn := make([]*pbhealth.SurveyResponse, 0)
+
+	for _, val := range m.responses {
+		if val.UserID == userID && (surveyID == "" || val.SurveyID == surveyID) {
+			toReturn = append(toReturn, val)
+		}
+	}
+
+	sort.SliceStable(toReturn, func(i, j int) bool {
+		return toReturn[i].SubmittedAt.AsTime().Before(toReturn[j].SubmittedAt.AsTime())
+	})
+
+	return toReturn, nil
+}
+
+func (m *MockSurveyRepository) DeleteUserSurveyResponses(ctx context.Context, userID int64) (uint32, error) {
+	var numDeleted uint32
+	for key, val := range m.responses {
+		if val.UserID == userID {
+			delete(m.responses, key)
+			numDeleted++
+		}
+	}
+
+	return numDeleted, nil
+}
+
+func surveyNotFound() error {
+	return status.Errorf(codes.NotFound, "Survey not found")
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+const assessmentCollectionName = "assessments"
+
+type MongoAssessmentRepository struct {
+	client               *mongo.Client
+	assessmentCollection *mongo.Collection
+
+	logger *zap.SugaredLogger
+}
+
+func NewMongoAssessmentRepository(client *mongo.Client, logger *zap.SugaredLogger) *MongoAssessmentRepository {
+	return &MongoAssessmentRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (m *MongoAssessmentRepository) SetCollections(databaseName string) {
+	m.assessmentCollection = m.client.Database(databaseName).Collection(assessmentCollectionName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := m.assessmentCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: append(bson.D{{Key: "userid", Value: 1}}, dateSort("assessmentdate", 1)...),
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating assessment indexes: %v", err)
+	}
+}
+
+func (m *MongoAssessmentRepository) AddAssessment(ctx context.Context, assessment *pbhealth.Assessment) (string, error) {
+	fields, err := documentFields(assessment)
+	if err != nil {
+		m.logger.Errorf("Error encoding assessment: %v", err)
+		return "", err
+	}
+
+	objectID := primitive.NewObjectID()
+	document := append(bson.D{{Key: "_id", Value: objectID}}, fields...)
+
+	if _, err = m.assessmentCollection.InsertOne(ctx, document); err != nil {
+		m.logger.Errorf("Error adding assessment: %v", err)
+		return "", err
+	}
+	assessment.Id = objectID.Hex()
+
+	return assessment.Id, nil
+}
+
+func (m *MongoAssessmentRepository) GetAssessments(ctx context.Context, userID int64, query *AssessmentQuery) ([]*pbhealth.Assessment, error) {
+	toReturn := make([]*pbhealth.Assessment, 0)
+
+	filter := bson.M{"userid": userID}
+	if len(query.Types) > 0 {
+		filter["type"] = bson.M{"$in": query.Types}
+	}
+	filter = withFilter(filter, dateRange("assessmentdate", query.StartDate, query.EndDate))
+
+	opts := options.Find().SetSort(append(dateSort("assessmentdate", 1), bson.E{Key: "submittedat.seconds", Value: 1}))
+	cur, err := m.assessmentCollection.Find(ctx, filter, opts)
+	if err != nil {
+		m.logger.Errorf("Error finding assessments: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		assessment := &pbhealth.Assessment{}
+		if err = cur.Decode(assessment); err != nil {
+			m.logger.Errorf("Error decoding assessment: %v", err)
+			return toReturn, err
+		}
+		assessment.Id = cur.Current.Lookup("_id").ObjectID().Hex()
+		toReturn = append(toReturn, assessment)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoAssessmentRepository) DeleteUserAssessments(ctx context.Context, userID int64) (uint32, error) {
+	res, err := m.assessmentCollection.DeleteMany(ctx, bson.M{"userid": userID})
+	if err != nil {
+		m.logger.Errorf("Error deleting assessments: %v", err)
+		return 0, err
+	}
+
+	return uint32(res.DeletedCount), nil
+}
@@ -2,22 +2,41 @@ package database
 
 import (
 	"context"
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/tag"
 	pbcommon "github.com/kic/health/pkg/proto/common"
 	pbhealth "github.com/kic/health/pkg/proto/health"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"go.uber.org/zap"
-	"math"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 const (
-	fileCollectionName = "health"
+	fileCollectionName        = "health"
+	scoreCollectionName       = "scores"
+	idempotencyCollectionName = "idempotency"
+	revisionCollectionName    = "revisions"
+	grantCollectionName       = "grants"
+	keyCollectionName         = "keys"
 )
 
+// number of logs written per bulk write while migrating
+const migrationBatchSize = 500
+
 type MongoRepository struct {
-	client         *mongo.Client
-	fileCollection *mongo.Collection
+	client                *mongo.Client
+	fileCollection        *mongo.Collection
+	scoreCollection       *mongo.Collection
+	idempotencyCollection *mongo.Collection
+	revisionCollection    *mongo.Collection
+	grantCollection       *mongo.Collection
+	keyCollection         *mongo.Collection
 
 	logger *zap.SugaredLogger
 }
@@ -31,25 +50,213 @@ func NewMongoRepository(client *mongo.Client, logger *zap.SugaredLogger) *MongoR
 
 func (m *MongoRepository) SetCollections(databaseName string) {
 	m.fileCollection = m.client.Database(databaseName).Collection(fileCollectionName)
+	m.scoreCollection = m.client.Database(databaseName).Collection(scoreCollectionName)
+	m.idempotencyCollection = m.client.Database(databaseName).Collection(idempotencyCollectionName)
+	m.revisionCollection = m.client.Database(databaseName).Collection(revisionCollectionName)
+	m.grantCollection = m.client.Database(databaseName).Collection(grantCollectionName)
+	m.keyCollection = m.client.Database(databaseName).Collection(keyCollectionName)
+
+	m.createIndexes()
+}
+
+// createIndexes - set up the indexes the repository relies on for uniqueness and expiry
+func (m *MongoRepository) createIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := m.idempotencyCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userid", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expiresat", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating idempotency indexes: %v", err)
+	}
+
+	_, err = m.revisionCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "logid", Value: 1}, {Key: "version", Value: -1}},
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating revision indexes: %v", err)
+	}
+
+	_, err = m.grantCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "ownerid", Value: 1}, {Key: "granteeid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "granteeid", Value: 1}},
+		},
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating access grant indexes: %v", err)
+	}
+
+	_, err = m.keyCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "userid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating data key indexes: %v", err)
+	}
+}
+
+// adjustAggregate - apply the change in score totals and log counts from a write to the user's running totals
+func (m *MongoRepository) adjustAggregate(ctx context.Context, userID int64, delta *ScoreAggregate) error {
+	if delta.isZero() {
+		return nil
+	}
+
+	inc := bson.M{"sum": delta.Sum, "count": delta.Count}
+	for dimension, totals := range delta.Dimensions {
+		inc["dimensions."+dimension+".sum"] = totals.Sum
+		inc["dimensions."+dimension+".count"] = totals.Count
+	}
+
+	_, err := m.scoreCollection.UpdateOne(
+		ctx,
+		bson.M{"userid": userID},
+		bson.M{
+			"$inc": inc,
+			"$set": bson.M{"lastupdated": time.Now()},
+		},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		m.logger.Errorf("Error updating score aggregate for user %v: %v", userID, err)
+	}
+
+	return err
+}
+
+// logFields - the stored fields of a log, without its ID, which lives in the document _id
+func logFields(healthLog *pbhealth.MentalHealthLog) (bson.D, error) {
+	return documentFields(healthLog)
+}
+
+// documentFields - the fields of a proto message as stored, leaving out its string ID, which is kept as the
+// document _id instead
+func documentFields(message interface{}) (bson.D, error) {
+	raw, err := bson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields bson.D
+	if err = bson.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	toReturn := make(bson.D, 0, len(fields))
+	for _, field := range fields {
+		if field.Key != "id" {
+			toReturn = append(toReturn, field)
+		}
+	}
+	return toReturn, nil
+}
+
+// decodeLog - decode the log under the cursor, filling in its ID from the document _id
+func decodeLog(cur *mongo.Cursor) (*pbhealth.MentalHealthLog, error) {
+	healthLog := &pbhealth.MentalHealthLog{}
+	if err := cur.Decode(healthLog); err != nil {
+		return nil, err
+	}
+	healthLog.Id = cur.Current.Lookup("_id").ObjectID().Hex()
+	return healthLog, nil
+}
+
+// logIDFilter - match the log with the given ID, belonging to the given user, unless it is in the trash
+func logIDFilter(userID int64, id string) (bson.M, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Health Log not found")
+	}
+	return bson.M{"_id": objectID, "userid": userID, "deletedat": nil}, nil
+}
+
+// trashUpdate - the update that soft deletes a log, hiding it from every read until it is restored or purged
+func trashUpdate() bson.M {
+	return bson.M{
+		"$set": bson.M{"deletedat": timestamppb.Now()},
+		"$inc": bson.M{"version": 1},
+	}
 }
 
 func (m *MongoRepository) AddMentalHealthLog(ctx context.Context, healthLog *pbhealth.MentalHealthLog) (string, error) {
-	res, err := m.fileCollection.InsertOne(context.TODO(), healthLog)
+	healthLog.Version = 1
+	fields, err := logFields(healthLog)
+	if err != nil {
+		m.logger.Errorf("Error encoding mental health log: %v", err)
+		return "", err
+	}
+
+	objectID := primitive.NewObjectID()
+	_, err = m.fileCollection.InsertOne(ctx, append(bson.D{{Key: "_id", Value: objectID}}, fields...))
 	if err != nil {
 		m.logger.Errorf("Error adding mental health log: %v", err)
 		return "", err
 	}
 	var toReturn string
-	toReturn = res.InsertedID.(primitive.ObjectID).Hex()
+	toReturn = objectID.Hex()
+	healthLog.Id = toReturn
+
+	err = m.adjustAggregate(ctx, healthLog.UserID, aggregateDelta([]*pbhealth.MentalHealthLog{healthLog}, nil))
 
 	return toReturn, err
 
 }
 
+func (m *MongoRepository) AddMentalHealthLogs(ctx context.Context, healthLogs []*pbhealth.MentalHealthLog) ([]string, error) {
+	toReturn := make([]string, 0, len(healthLogs))
+	if len(healthLogs) == 0 {
+		return toReturn, nil
+	}
+
+	documents := make([]interface{}, 0, len(healthLogs))
+	for _, healthLog := range healthLogs {
+		healthLog.Version = 1
+		fields, err := logFields(healthLog)
+		if err != nil {
+			m.logger.Errorf("Error encoding mental health log: %v", err)
+			return nil, err
+		}
+
+		objectID := primitive.NewObjectID()
+		documents = append(documents, append(bson.D{{Key: "_id", Value: objectID}}, fields...))
+		toReturn = append(toReturn, objectID.Hex())
+	}
+
+	_, err := m.fileCollection.InsertMany(ctx, documents)
+	if err != nil {
+		m.logger.Errorf("Error adding mental health logs: %v", err)
+		return nil, err
+	}
+
+	// one aggregate update per user rather than per log
+	byUser := make(map[int64][]*pbhealth.MentalHealthLog)
+	for i, healthLog := range healthLogs {
+		healthLog.Id = toReturn[i]
+		byUser[healthLog.UserID] = append(byUser[healthLog.UserID], healthLog)
+	}
+	for userID, added := range byUser {
+		if err = m.adjustAggregate(ctx, userID, aggregateDelta(added, nil)); err != nil {
+			return toReturn, err
+		}
+	}
+
+	return toReturn, nil
+}
+
 func (m *MongoRepository) GetAllMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
 	toReturn := make([]*pbhealth.MentalHealthLog, 0)
 
-	filter := bson.M{"userid": userID}
+	filter := bson.M{"userid": userID, "deletedat": nil}
 
 	cur, err := m.fileCollection.Find(ctx, filter)
 	if err != nil {
@@ -57,8 +264,7 @@ func (m *MongoRepository) GetAllMentalHealthLogs(ctx context.Context, userID int
 	}
 
 	for cur.Next(context.Background()) {
-		healthLog := &pbhealth.MentalHealthLog{}
-		err = cur.Decode(healthLog)
+		healthLog, err := decodeLog(cur)
 		if err != nil {
 			m.logger.Errorf("Error decoding file: %v", err)
 			return toReturn, err
@@ -72,7 +278,7 @@ func (m *MongoRepository) GetAllMentalHealthLogs(ctx context.Context, userID int
 func (m *MongoRepository) GetAllMentalHealthLogsByDate(ctx context.Context, userID int64, date *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
 	toReturn := make([]*pbhealth.MentalHealthLog, 0)
 
-	filter := bson.M{"userid": userID, "logdate": date}
+	filter := withFilter(bson.M{"userid": userID, "deletedat": nil}, dateEquals("logdate", date))
 
 	cur, err := m.fileCollection.Find(ctx, filter)
 	if err != nil {
@@ -80,8 +286,7 @@ func (m *MongoRepository) GetAllMentalHealthLogsByDate(ctx context.Context, user
 	}
 
 	for cur.Next(context.Background()) {
-		healthLog := &pbhealth.MentalHealthLog{}
-		err = cur.Decode(healthLog)
+		healthLog, err := decodeLog(cur)
 		if err != nil {
 			m.logger.Errorf("Error decoding file: %v", err)
 			return toReturn, err
@@ -92,35 +297,236 @@ func (m *MongoRepository) GetAllMentalHealthLogsByDate(ctx context.Context, user
 	return toReturn, err
 }
 
+func (m *MongoRepository) GetAllMentalHealthLogsInRange(ctx context.Context, userID int64, startDate *pbcommon.Date, endDate *pbcommon.Date) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	filter := withFilter(bson.M{"userid": userID, "deletedat": nil}, dateRange("logdate", startDate, endDate))
+
+	cur, err := m.fileCollection.Find(ctx, filter)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health logs: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		healthLog, err := decodeLog(cur)
+		if err != nil {
+			m.logger.Errorf("Error decoding file: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, healthLog)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoRepository) GetMentalHealthLogsInRange(ctx context.Context, userID int64, query *LogRangeQuery) ([]*pbhealth.MentalHealthLog, string, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	cursor, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return toReturn, "", err
+	}
+
+	var deletedAt interface{}
+	if query.Deleted {
+		deletedAt = bson.M{"$ne": nil}
+	}
+	filter := withFilter(bson.M{"userid": userID, "deletedat": deletedAt}, dateRange("logdate", query.StartDate, query.EndDate))
+
+	direction := 1
+	after := "$gt"
+	if query.Descending {
+		direction = -1
+		after = "$lt"
+	}
+
+	if cursor != nil {
+		cursorID, err := primitive.ObjectIDFromHex(cursor.ID)
+		if err != nil {
+			return toReturn, "", status.Errorf(codes.InvalidArgument, "Invalid page token")
+		}
+		sameDate := dateEquals("logdate", cursor.date())
+		sameDate["_id"] = bson.M{after: cursorID}
+		filter = withFilter(filter, bson.M{"$or": bson.A{
+			dateCompare("logdate", after, cursor.date()),
+			sameDate,
+		}})
+	}
+
+	findOptions := options.Find().
+		SetSort(append(dateSort("logdate", direction), bson.E{Key: "_id", Value: direction})).
+		SetLimit(int64(query.PageSize + 1))
+
+	cur, err := m.fileCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health logs: %v", err)
+		return toReturn, "", err
+	}
+	defer cur.Close(ctx)
+
+	var lastID string
+	for cur.Next(ctx) {
+		if len(toReturn) == query.PageSize {
+			return toReturn, encodePageToken(toReturn[len(toReturn)-1].LogDate, lastID), nil
+		}
+
+		healthLog, err := decodeLog(cur)
+		if err != nil {
+			m.logger.Errorf("Error decoding file: %v", err)
+			return toReturn, "", err
+		}
+		lastID = healthLog.Id
+		toReturn = append(toReturn, healthLog)
+	}
+
+	return toReturn, "", cur.Err()
+}
+
 func (m *MongoRepository) GetOverallScore(ctx context.Context, userID int64) (int32, error) {
-	logs, err := m.GetAllMentalHealthLogs(ctx, userID)
-	m.logger.Infof("Logs fetched for user (ID = %v\n): %v\n", userID, logs)
+	aggregate := &ScoreAggregate{}
+
+	err := m.scoreCollection.FindOne(ctx, bson.M{"userid": userID}).Decode(aggregate)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	} else if err != nil {
+		m.logger.Errorf("cannot get score aggregate for user: %v \n", err)
+		return 0, err
+	}
+
+	overallScore := aggregate.Average()
+
+	m.logger.Infof("Average score for user (ID = %v): %v\n", userID, overallScore)
+
+	return overallScore, nil
+}
 
+// GetDimensionScore - the rounded mean score of a mood dimension, and whether the user has rated it at all
+func (m *MongoRepository) GetDimensionScore(ctx context.Context, userID int64, dimension string) (int32, bool, error) {
+	aggregate := &ScoreAggregate{}
+
+	err := m.scoreCollection.FindOne(ctx, bson.M{"userid": userID}).Decode(aggregate)
+	if err == mongo.ErrNoDocuments {
+		return 0, false, nil
+	} else if err != nil {
+		m.logger.Errorf("cannot get score aggregate for user: %v \n", err)
+		return 0, false, err
+	}
+
+	dimensionScore, rated := aggregate.DimensionAverage(dimension)
+
+	m.logger.Infof("Average %v score for user (ID = %v): %v\n", dimension, userID, dimensionScore)
+
+	return dimensionScore, rated, nil
+}
+
+func (m *MongoRepository) RebuildScoreAggregates(ctx context.Context, userIDs []int64) (uint32, error) {
+	match := bson.M{}
+	if len(userIDs) > 0 {
+		match = bson.M{"userid": bson.M{"$in": userIDs}}
+	}
+
+	dimensions, err := m.dimensionAggregates(ctx, match)
 	if err != nil {
-		m.logger.Errorf("cannot get mental health logs for user: %v \n", err)
+		return 0, err
 	}
-	var totalScore float64
-	totalScore = 0
 
-	numLogs := 0
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"$and": bson.A{match, bson.M{"deletedat": nil}}}},
+		bson.M{"$group": bson.M{
+			"_id":   "$userid",
+			"sum":   bson.M{"$sum": "$score"},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
 
-	for _, log := range logs {
-		totalScore += float64(log.Score)
-		numLogs++
+	cur, err := m.fileCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		m.logger.Errorf("Error aggregating mental health log scores: %v", err)
+		return 0, err
 	}
+	defer cur.Close(ctx)
 
-	var overallScore int32
-	if numLogs == 0 {
-		overallScore = 0
-	} else {
-		overallScore = int32(math.Round(totalScore / float64(numLogs)))
+	rebuiltIDs := make([]int64, 0)
+	for cur.Next(ctx) {
+		var group struct {
+			UserID int64 `bson:"_id"`
+			Sum    int64 `bson:"sum"`
+			Count  int64 `bson:"count"`
+		}
+		if err = cur.Decode(&group); err != nil {
+			m.logger.Errorf("Error decoding score aggregate: %v", err)
+			return uint32(len(rebuiltIDs)), err
+		}
+
+		aggregate := &ScoreAggregate{UserID: group.UserID, Sum: group.Sum, Count: group.Count, Dimensions: dimensions[group.UserID], LastUpdated: time.Now()}
+		_, err = m.scoreCollection.ReplaceOne(ctx, bson.M{"userid": group.UserID}, aggregate, options.Replace().SetUpsert(true))
+		if err != nil {
+			m.logger.Errorf("Error saving score aggregate for user %v: %v", group.UserID, err)
+			return uint32(len(rebuiltIDs)), err
+		}
+		rebuiltIDs = append(rebuiltIDs, group.UserID)
+	}
+	if err = cur.Err(); err != nil {
+		return uint32(len(rebuiltIDs)), err
+	}
+
+	// users in scope without any logs left should not keep a stale total
+	stale := bson.M{"userid": bson.M{"$nin": rebuiltIDs}}
+	if len(userIDs) > 0 {
+		stale = bson.M{"$and": bson.A{match, stale}}
+	}
+	_, err = m.scoreCollection.DeleteMany(ctx, stale)
+	if err != nil {
+		m.logger.Errorf("Error removing stale score aggregates: %v", err)
+	}
+
+	return uint32(len(rebuiltIDs)), err
+}
+
+// dimensionAggregates - the totals of every mood dimension rated in the live logs matching match, by user
+func (m *MongoRepository) dimensionAggregates(ctx context.Context, match bson.M) (map[int64]map[string]*DimensionAggregate, error) {
+	toReturn := make(map[int64]map[string]*DimensionAggregate)
+
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"$and": bson.A{match, bson.M{"deletedat": nil}}}},
+		bson.M{"$unwind": "$dimensionscores"},
+		bson.M{"$group": bson.M{
+			"_id":   bson.M{"userid": "$userid", "dimension": "$dimensionscores.dimension"},
+			"sum":   bson.M{"$sum": "$dimensionscores.score"},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+
+	cur, err := m.fileCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		m.logger.Errorf("Error aggregating mood dimension scores: %v", err)
+		return toReturn, err
 	}
+	defer cur.Close(ctx)
 
-	m.logger.Infof("Total sum of log scores for user (ID = %v\n): %v\n", userID, totalScore)
-	m.logger.Infof("Number of total logs for user (ID = %v): %v\n:", userID, numLogs)
-	m.logger.Infof("Average score for user (ID = %v): %v\n:", userID, overallScore)
+	for cur.Next(ctx) {
+		var group struct {
+			ID struct {
+				UserID    int64  `bson:"userid"`
+				Dimension string `bson:"dimension"`
+			} `bson:"_id"`
+			Sum   int64 `bson:"sum"`
+			Count int64 `bson:"count"`
+		}
+		if err = cur.Decode(&group); err != nil {
+			m.logger.Errorf("Error decoding mood dimension aggregate: %v", err)
+			return toReturn, err
+		}
+
+		if toReturn[group.ID.UserID] == nil {
+			toReturn[group.ID.UserID] = make(map[string]*DimensionAggregate)
+		}
+		toReturn[group.ID.UserID][group.ID.Dimension] = &DimensionAggregate{Sum: group.Sum, Count: group.Count}
+	}
 
-	return overallScore, err
+	return toReturn, cur.Err()
 }
 
 func (m *MongoRepository) DeleteMentalHealthLogs(ctx context.Context, userID int64, date *pbcommon.Date, all bool) (uint32, error) {
@@ -128,38 +534,875 @@ func (m *MongoRepository) DeleteMentalHealthLogs(ctx context.Context, userID int
 	var filter bson.M // declaring vbariable
 
 	if all {
-		filter = bson.M{"userid": userID} // filtering by user id and date
+		filter = bson.M{"userid": userID, "deletedat": nil} // filtering by user id and date
 	} else {
-		filter = bson.M{"userid": userID, "logdate": date} // filtering by user id and date
+		filter = withFilter(bson.M{"userid": userID, "deletedat": nil}, dateEquals("logdate", date)) // filtering by user id and date
 	}
 
-	res, err := m.fileCollection.DeleteMany(ctx, filter) // deleting all health logs with the given date
+	objectIDs, err := m.findLogIDs(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
 
+	// moving the logs to the trash one at a time, so the aggregate loses exactly the scores that were trashed
+	removed, err := m.updateEachLog(ctx, objectIDs, filter, trashUpdate())
 	if err != nil {
 		m.logger.Errorf("cannot delete mental health logs for user: %v \n", err)
 	}
 
-	numDeleted := uint32(res.DeletedCount) // getting number of entries deleted
+	numDeleted := uint32(len(removed)) // getting number of entries deleted
+
+	if aggregateErr := m.adjustAggregate(ctx, userID, aggregateDelta(nil, removed)); err == nil {
+		err = aggregateErr
+	}
 
 	return numDeleted, err
 }
 
-func (m *MongoRepository) UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog) error {
+func (m *MongoRepository) UpdateMentalHealthLogs(ctx context.Context, userID int64, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) error {
 
-	filter := bson.M{"userid": userID, "logdate": healthLog.LogDate}
+	paths, err := resolveUpdatePaths(paths, ScorePath, JournalNamePath, DimensionScoresPath, TagsPath)
+	if err != nil {
+		return err
+	}
+
+	filter := withFilter(bson.M{"userid": userID, "deletedat": nil}, dateEquals("logdate", healthLog.LogDate))
 	update := bson.M{
-		"$set": healthLog,
+		"$set": updateDocument(healthLog, paths),
+		"$inc": bson.M{"version": 1},
+	}
+
+	if expectedVersion != 0 {
+		// checking every log of the date up front, so a stale version fails before anything is written
+		numConflicts, err := m.fileCollection.CountDocuments(ctx, withFilter(bson.M{
+			"userid":    userID,
+			"version":   bson.M{"$ne": expectedVersion},
+			"deletedat": nil,
+		}, dateEquals("logdate", healthLog.LogDate)))
+		if err != nil {
+			m.logger.Errorf("Error checking mental health log versions: %v", err)
+			return err
+		}
+		if numConflicts > 0 {
+			return versionConflict()
+		}
+		filter["version"] = expectedVersion
+	}
+
+	objectIDs, err := m.findLogIDs(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	// the logs as each write replaced them, kept as revisions and so the aggregate moves by the score differences
+	previousLogs, updateErr := m.updateEachLog(ctx, objectIDs, filter, update)
+	if updateErr == nil && expectedVersion != 0 && len(previousLogs) != len(objectIDs) {
+		// a concurrent write moved some of the logs past the expected version between listing and updating them
+		updateErr = versionConflict()
+	}
+
+	if err = m.recordRevisions(ctx, previousLogs, healthLog, paths); err != nil {
+		return err
+	}
+
+	if err = m.adjustAggregate(ctx, userID, updateDelta(previousLogs, healthLog, paths)); err != nil {
+		return err
+	}
+
+	return updateErr
+}
+
+// findLogIDs - the document IDs of every log matching filter
+func (m *MongoRepository) findLogIDs(ctx context.Context, filter bson.M) ([]primitive.ObjectID, error) {
+	toReturn := make([]primitive.ObjectID, 0)
+
+	cur, err := m.fileCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		m.logger.Errorf("Error finding mental health logs: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		toReturn = append(toReturn, cur.Current.Lookup("_id").ObjectID())
+	}
+
+	return toReturn, cur.Err()
+}
+
+// updateEachLog - apply update to each log of objectIDs that still matches filter, one conditional write at a time,
+// returning every log as it was just before its own write. Reading a log atomically with writing it keeps revisions
+// and the score aggregate in step with what was actually overwritten, whatever else writes concurrently. Logs that
+// stopped matching filter are skipped, and on error the logs already written are returned with it.
+func (m *MongoRepository) updateEachLog(ctx context.Context, objectIDs []primitive.ObjectID, filter bson.M, update interface{}) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0, len(objectIDs))
+	for _, objectID := range objectIDs {
+		logFilter := bson.M{}
+		for key, val := range filter {
+			logFilter[key] = val
+		}
+		logFilter["_id"] = objectID
+
+		previous := &pbhealth.MentalHealthLog{}
+		err := m.fileCollection.FindOneAndUpdate(
+			ctx,
+			logFilter,
+			update,
+			options.FindOneAndUpdate().SetReturnDocument(options.Before)).Decode(previous)
+		if err == mongo.ErrNoDocuments {
+			continue
+		} else if err != nil {
+			m.logger.Errorf("Error updating mental health log: %v", err)
+			return toReturn, err
+		}
+		previous.Id = objectID.Hex()
+		toReturn = append(toReturn, previous)
+	}
+
+	return toReturn, nil
+}
+
+// findLogs - every log matching filter
+func (m *MongoRepository) findLogs(ctx context.Context, filter bson.M) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	cur, err := m.fileCollection.Find(ctx, filter)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health logs: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		healthLog, err := decodeLog(cur)
+		if err != nil {
+			m.logger.Errorf("Error decoding file: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, healthLog)
+	}
+
+	return toReturn, cur.Err()
+}
+
+// recordRevisions - keep each of previousLogs as a revision, for the fields update changed on it
+func (m *MongoRepository) recordRevisions(ctx context.Context, previousLogs []*pbhealth.MentalHealthLog, update *pbhealth.MentalHealthLog, paths []string) error {
+	revisions := make([]interface{}, 0, len(previousLogs))
+	for _, previous := range previousLogs {
+		if revision := newRevision(ctx, previous, update, paths); revision != nil {
+			revisions = append(revisions, revision)
+		}
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	_, err := m.revisionCollection.InsertMany(ctx, revisions)
+	if err != nil {
+		m.logger.Errorf("Error recording mental health log revisions: %v", err)
+	}
+
+	return err
+}
+
+// updateDocument - the $set document applying the fields named by paths from update
+func updateDocument(update *pbhealth.MentalHealthLog, paths []string) bson.M {
+	set := bson.M{}
+	for _, path := range paths {
+		switch path {
+		case LogDatePath:
+			set["logdate"] = update.LogDate
+		case ScorePath:
+			set["score"] = update.Score
+		case JournalNamePath:
+			set["journalname"] = update.JournalName
+		case DimensionScoresPath:
+			set["dimensionscores"] = update.DimensionScores
+		case TagsPath:
+			set["tags"] = update.Tags
+		case LoggedAtPath:
+			set["loggedat"] = update.LoggedAt
+		case TimeZonePath:
+			set["timezone"] = update.TimeZone
+		case DateOnlyPath:
+			set["dateonly"] = update.DateOnly
+		}
+	}
+	return set
+}
+
+func (m *MongoRepository) GetMentalHealthLogByID(ctx context.Context, userID int64, id string) (*pbhealth.MentalHealthLog, error) {
+	filter, err := logIDFilter(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	healthLog := &pbhealth.MentalHealthLog{}
+	err = m.fileCollection.FindOne(ctx, filter).Decode(healthLog)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "Health Log not found")
+	} else if err != nil {
+		m.logger.Errorf("Error finding mental health log: %v", err)
+		return nil, err
+	}
+	healthLog.Id = id
+
+	return healthLog, nil
+}
+
+func (m *MongoRepository) UpdateMentalHealthLogByID(ctx context.Context, userID int64, id string, healthLog *pbhealth.MentalHealthLog, paths []string, expectedVersion int64) (int64, error) {
+	paths, err := resolveUpdatePaths(paths, LogDatePath, ScorePath, JournalNamePath, DimensionScoresPath, TagsPath, LoggedAtPath, TimeZonePath, DateOnlyPath)
+	if err != nil {
+		return 0, err
+	}
+
+	if hasPath(paths, LogDatePath) && healthLog.LogDate == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for UpdateMentalHealthLogByID")
+	}
+
+	filter, err := logIDFilter(userID, id)
+	if err != nil {
+		return 0, err
 	}
 
-	_, err := m.fileCollection.UpdateOne(
+	previous := &pbhealth.MentalHealthLog{}
+	err = m.fileCollection.FindOneAndUpdate(
 		ctx,
-		filter,
-		update)
+		versionFilter(filter, expectedVersion),
+		bson.M{"$set": updateDocument(healthLog, paths), "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before)).Decode(previous)
+	if err == mongo.ErrNoDocuments {
+		return 0, m.missingOrConflict(ctx, filter)
+	} else if err != nil {
+		m.logger.Errorf("Error updating mental health log: %v", err)
+		return 0, err
+	}
+	previous.Id = id
+
+	if err = m.recordRevisions(ctx, []*pbhealth.MentalHealthLog{previous}, healthLog, paths); err != nil {
+		return 0, err
+	}
+
+	err = m.adjustAggregate(ctx, userID, updateDelta([]*pbhealth.MentalHealthLog{previous}, healthLog, paths))
+
+	return previous.Version + 1, err
+}
+
+func (m *MongoRepository) GetMentalHealthLogRevisions(ctx context.Context, userID int64, id string) ([]*pbhealth.HealthLogRevision, error) {
+	toReturn := make([]*pbhealth.HealthLogRevision, 0)
+
+	filter, err := logIDFilter(userID, id)
+	if err != nil {
+		return toReturn, err
+	}
+
+	numFound, err := m.fileCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health log: %v", err)
+		return toReturn, err
+	}
+	if numFound == 0 {
+		return toReturn, status.Errorf(codes.NotFound, "Health Log not found")
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+	cur, err := m.revisionCollection.Find(ctx, bson.M{"logid": id, "userid": userID}, findOptions)
 	if err != nil {
-		m.logger.Errorf("Error updating mentalh health log: %v", err)
+		m.logger.Errorf("Error finding mental health log revisions: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		revision := &pbhealth.HealthLogRevision{}
+		if err = cur.Decode(revision); err != nil {
+			m.logger.Errorf("Error decoding revision: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, revision)
+	}
+
+	return toReturn, cur.Err()
+}
+
+// GetRevisionsOfMentalHealthLogs - the revisions of the given logs, whether live or in the trash, grouped by log in
+// the order of ids and newest first within a log
+func (m *MongoRepository) GetRevisionsOfMentalHealthLogs(ctx context.Context, userID int64, ids []string) ([]*pbhealth.HealthLogRevision, error) {
+	toReturn := make([]*pbhealth.HealthLogRevision, 0)
+	if len(ids) == 0 {
+		return toReturn, nil
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+	cur, err := m.revisionCollection.Find(ctx, bson.M{"logid": bson.M{"$in": ids}, "userid": userID}, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health log revisions: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	byLog := make(map[string][]*pbhealth.HealthLogRevision, len(ids))
+	for cur.Next(ctx) {
+		revision := &pbhealth.HealthLogRevision{}
+		if err = cur.Decode(revision); err != nil {
+			m.logger.Errorf("Error decoding revision: %v", err)
+			return toReturn, err
+		}
+		byLog[revision.LogID] = append(byLog[revision.LogID], revision)
+	}
+	if err = cur.Err(); err != nil {
+		return toReturn, err
+	}
+
+	for _, id := range ids {
+		toReturn = append(toReturn, byLog[id]...)
+	}
+	return toReturn, nil
+}
+
+func (m *MongoRepository) RevertMentalHealthLogToRevision(ctx context.Context, userID int64, id string, revisionVersion int64, expectedVersion int64) (int64, error) {
+	revision := &pbhealth.HealthLogRevision{}
+	err := m.revisionCollection.FindOne(ctx, bson.M{"logid": id, "userid": userID, "version": revisionVersion}).Decode(revision)
+	if err == mongo.ErrNoDocuments {
+		return 0, revisionNotFound()
+	} else if err != nil {
+		m.logger.Errorf("Error finding mental health log revision: %v", err)
+		return 0, err
+	}
+
+	return m.UpdateMentalHealthLogByID(ctx, userID, id, revertedLog(revision), revertPaths, expectedVersion)
+}
+
+// versionFilter - narrow filter to logs still at the version a write expects, 0 accepting any version
+func versionFilter(filter bson.M, expectedVersion int64) bson.M {
+	if expectedVersion == 0 {
+		return filter
+	}
+
+	toReturn := bson.M{"version": expectedVersion}
+	for key, val := range filter {
+		toReturn[key] = val
+	}
+	return toReturn
+}
+
+// missingOrConflict - explain why a versioned write on the log matching filter found nothing to change
+func (m *MongoRepository) missingOrConflict(ctx context.Context, filter bson.M) error {
+	numFound, err := m.fileCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health log: %v", err)
+		return err
+	}
+	if numFound > 0 {
+		return versionConflict()
+	}
+	return status.Errorf(codes.NotFound, "Health Log not found")
+}
+
+func (m *MongoRepository) DeleteMentalHealthLogByID(ctx context.Context, userID int64, id string, expectedVersion int64) error {
+	filter, err := logIDFilter(userID, id)
+	if err != nil {
+		return err
+	}
+
+	removed := &pbhealth.MentalHealthLog{}
+	err = m.fileCollection.FindOneAndUpdate(ctx, versionFilter(filter, expectedVersion), trashUpdate()).Decode(removed)
+	if err == mongo.ErrNoDocuments {
+		return m.missingOrConflict(ctx, filter)
+	} else if err != nil {
+		m.logger.Errorf("Error deleting mental health log: %v", err)
+		return err
+	}
+
+	return m.adjustAggregate(ctx, userID, aggregateDelta(nil, []*pbhealth.MentalHealthLog{removed}))
+}
+
+func (m *MongoRepository) ReserveIdempotencyKey(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, error) {
+	_, err := m.idempotencyCollection.InsertOne(ctx, record)
+	if err == nil {
+		return nil, nil
+	} else if !mongo.IsDuplicateKeyError(err) {
+		m.logger.Errorf("Error reserving idempotency key: %v", err)
+		return nil, err
+	}
+
+	// the expiry index only sweeps periodically, so an expired record can still be in the way
+	res, err := m.idempotencyCollection.ReplaceOne(
+		ctx,
+		bson.M{"userid": record.UserID, "key": record.Key, "expiresat": bson.M{"$lte": time.Now()}},
+		record)
+	if err != nil {
+		m.logger.Errorf("Error reserving idempotency key: %v", err)
+		return nil, err
+	}
+	if res.ModifiedCount > 0 {
+		return nil, nil
+	}
+
+	existing := &IdempotencyRecord{}
+	err = m.idempotencyCollection.FindOne(ctx, bson.M{"userid": record.UserID, "key": record.Key}).Decode(existing)
+	if err != nil {
+		m.logger.Errorf("Error finding idempotency key: %v", err)
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (m *MongoRepository) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, token string, response []byte, expiresAt time.Time) error {
+	res, err := m.idempotencyCollection.UpdateOne(
+		ctx,
+		bson.M{"userid": userID, "key": key, "token": token},
+		bson.M{"$set": bson.M{"response": response, "completed": true, "expiresat": expiresAt}})
+	if err != nil {
+		m.logger.Errorf("Error completing idempotency key: %v", err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return status.Errorf(codes.NotFound, "Idempotency key reservation not found")
+	}
+
+	return nil
+}
+
+func (m *MongoRepository) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string, token string) error {
+	_, err := m.idempotencyCollection.DeleteOne(ctx, bson.M{"userid": userID, "key": key, "token": token})
+	if err != nil {
+		m.logger.Errorf("Error releasing idempotency key: %v", err)
 	}
 
 	return err
 }
 
+func (m *MongoRepository) GetDeletedMentalHealthLogs(ctx context.Context, userID int64) ([]*pbhealth.MentalHealthLog, error) {
+	toReturn := make([]*pbhealth.MentalHealthLog, 0)
+
+	filter := bson.M{"userid": userID, "deletedat": bson.M{"$ne": nil}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "deletedat.seconds", Value: -1}})
+
+	cur, err := m.fileCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding deleted mental health logs: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		healthLog, err := decodeLog(cur)
+		if err != nil {
+			m.logger.Errorf("Error decoding file: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, healthLog)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoRepository) RestoreMentalHealthLogs(ctx context.Context, userID int64, ids []string, all bool) (uint32, error) {
+	if len(ids) == 0 && all == false {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid Argument for RestoreMentalHealthLogs")
+	}
+
+	filter := bson.M{"userid": userID, "deletedat": bson.M{"$ne": nil}}
+
+	if !all {
+		objectIDs := make([]primitive.ObjectID, 0, len(ids))
+		for _, id := range ids {
+			objectID, err := primitive.ObjectIDFromHex(id)
+			if err != nil {
+				return 0, status.Errorf(codes.NotFound, "Health Log not found")
+			}
+			objectIDs = append(objectIDs, objectID)
+		}
+		filter["_id"] = bson.M{"$in": objectIDs}
+	}
+
+	objectIDs, err := m.findLogIDs(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	restored, err := m.updateEachLog(ctx, objectIDs, filter, bson.M{
+		"$set": bson.M{"deletedat": nil},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		m.logger.Errorf("cannot restore mental health logs for user: %v \n", err)
+	}
+
+	numRestored := uint32(len(restored))
+
+	if aggregateErr := m.adjustAggregate(ctx, userID, aggregateDelta(restored, nil)); err == nil {
+		err = aggregateErr
+	}
+
+	return numRestored, err
+}
+
+func (m *MongoRepository) PurgeDeletedMentalHealthLogs(ctx context.Context, deletedBefore time.Time) (uint32, error) {
+	toPurge, err := m.findLogs(ctx, bson.M{"deletedat.seconds": bson.M{"$lt": deletedBefore.Unix()}})
+	if err != nil || len(toPurge) == 0 {
+		return 0, err
+	}
+
+	objectIDs := make([]primitive.ObjectID, 0, len(toPurge))
+	ids := make([]string, 0, len(toPurge))
+	for _, healthLog := range toPurge {
+		objectID, _ := primitive.ObjectIDFromHex(healthLog.Id)
+		objectIDs = append(objectIDs, objectID)
+		ids = append(ids, healthLog.Id)
+	}
+
+	res, err := m.fileCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objectIDs}})
+	if err != nil {
+		m.logger.Errorf("cannot purge deleted mental health logs: %v \n", err)
+		return 0, err
+	}
+
+	_, err = m.revisionCollection.DeleteMany(ctx, bson.M{"logid": bson.M{"$in": ids}})
+	if err != nil {
+		m.logger.Errorf("cannot purge revisions of deleted mental health logs: %v \n", err)
+	}
+
+	return uint32(res.DeletedCount), err
+}
 
+func (m *MongoRepository) SaveAccessGrant(ctx context.Context, grant *pbhealth.AccessGrant) error {
+	_, err := m.grantCollection.ReplaceOne(
+		ctx,
+		bson.M{"ownerid": grant.OwnerID, "granteeid": grant.GranteeID},
+		grant,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		m.logger.Errorf("Error saving access grant: %v", err)
+	}
+
+	return err
+}
+
+func (m *MongoRepository) DeleteAccessGrant(ctx context.Context, ownerID int64, granteeID int64) error {
+	res, err := m.grantCollection.DeleteOne(ctx, bson.M{"ownerid": ownerID, "granteeid": granteeID})
+	if err != nil {
+		m.logger.Errorf("Error deleting access grant: %v", err)
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return status.Errorf(codes.NotFound, "Access grant not found")
+	}
+
+	return nil
+}
+
+func (m *MongoRepository) GetAccessGrant(ctx context.Context, ownerID int64, granteeID int64) (*pbhealth.AccessGrant, error) {
+	filter := activeGrantFilter()
+	filter["ownerid"] = ownerID
+	filter["granteeid"] = granteeID
+
+	grant := &pbhealth.AccessGrant{}
+	err := m.grantCollection.FindOne(ctx, filter).Decode(grant)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "Access grant not found")
+	} else if err != nil {
+		m.logger.Errorf("Error finding access grant: %v", err)
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+func (m *MongoRepository) GetAccessGrantsByOwner(ctx context.Context, ownerID int64) ([]*pbhealth.AccessGrant, error) {
+	filter := activeGrantFilter()
+	filter["ownerid"] = ownerID
+	return m.findAccessGrants(ctx, filter)
+}
+
+func (m *MongoRepository) GetAccessGrantsByGrantee(ctx context.Context, granteeID int64) ([]*pbhealth.AccessGrant, error) {
+	filter := activeGrantFilter()
+	filter["granteeid"] = granteeID
+	return m.findAccessGrants(ctx, filter)
+}
+
+// activeGrantFilter - match the grants that have not expired yet
+func activeGrantFilter() bson.M {
+	return bson.M{"expiresat.seconds": bson.M{"$gt": time.Now().Unix()}}
+}
+
+// findAccessGrants - the grants matching filter, soonest to expire first
+func (m *MongoRepository) findAccessGrants(ctx context.Context, filter bson.M) ([]*pbhealth.AccessGrant, error) {
+	toReturn := make([]*pbhealth.AccessGrant, 0)
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "expiresat.seconds", Value: 1}})
+	cur, err := m.grantCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding access grants: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		grant := &pbhealth.AccessGrant{}
+		if err = cur.Decode(grant); err != nil {
+			m.logger.Errorf("Error decoding access grant: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, grant)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoRepository) AddDataKey(ctx context.Context, key *DataKey) (*DataKey, error) {
+	_, err := m.keyCollection.InsertOne(ctx, key)
+	if err == nil {
+		return key, nil
+	} else if !mongo.IsDuplicateKeyError(err) {
+		m.logger.Errorf("Error adding data key: %v", err)
+		return nil, err
+	}
+
+	// another write created the user's key first
+	return m.GetDataKey(ctx, key.UserID)
+}
+
+func (m *MongoRepository) GetDataKey(ctx context.Context, userID int64) (*DataKey, error) {
+	key := &DataKey{}
+	err := m.keyCollection.FindOne(ctx, bson.M{"userid": userID}).Decode(key)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "Data key not found")
+	} else if err != nil {
+		m.logger.Errorf("Error finding data key: %v", err)
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (m *MongoRepository) GetDataKeysToRotate(ctx context.Context, currentMasterKeyID string, limit int) ([]*DataKey, error) {
+	toReturn := make([]*DataKey, 0)
+
+	findOptions := options.Find().SetLimit(int64(limit))
+	cur, err := m.keyCollection.Find(ctx, bson.M{"masterkeyid": bson.M{"$ne": currentMasterKeyID}}, findOptions)
+	if err != nil {
+		m.logger.Errorf("Error finding data keys to rotate: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		key := &DataKey{}
+		if err = cur.Decode(key); err != nil {
+			m.logger.Errorf("Error decoding data key: %v", err)
+			return toReturn, err
+		}
+		toReturn = append(toReturn, key)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoRepository) RewrapDataKey(ctx context.Context, key *DataKey, previousMasterKeyID string) error {
+	res, err := m.keyCollection.ReplaceOne(ctx, bson.M{"userid": key.UserID, "masterkeyid": previousMasterKeyID}, key)
+	if err != nil {
+		m.logger.Errorf("Error rewrapping data key: %v", err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return status.Errorf(codes.Aborted, "Data key was changed by another write")
+	}
+
+	return nil
+}
+
+func (m *MongoRepository) DeleteDataKey(ctx context.Context, userID int64) error {
+	_, err := m.keyCollection.DeleteOne(ctx, bson.M{"userid": userID})
+	if err != nil {
+		m.logger.Errorf("Error deleting data key: %v", err)
+	}
+
+	return err
+}
+
+// MigrateLogTimes - give logs stored with only a date the start of that date in location as their loggedAt, one
+// batch at a time. Migrated logs keep their version, as nothing a user entered changes.
+func (m *MongoRepository) MigrateLogTimes(ctx context.Context, location *time.Location) (uint32, error) {
+	filter := bson.M{"loggedat": nil, "logdate": bson.M{"$ne": nil}}
+	opts := options.Find().SetProjection(bson.M{"logdate": 1}).SetBatchSize(migrationBatchSize)
+	cur, err := m.fileCollection.Find(ctx, filter, opts)
+	if err != nil {
+		m.logger.Errorf("Error finding mental health logs to migrate: %v", err)
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	var numMigrated uint32
+	migrate := func(models []mongo.WriteModel) error {
+		if len(models) == 0 {
+			return nil
+		}
+		res, err := m.fileCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if res != nil {
+			numMigrated += uint32(res.ModifiedCount)
+		}
+		return err
+	}
+
+	models := make([]mongo.WriteModel, 0, migrationBatchSize)
+	for cur.Next(ctx) {
+		var stored struct {
+			ID      primitive.ObjectID `bson:"_id"`
+			LogDate *pbcommon.Date     `bson:"logdate"`
+		}
+		if err = cur.Decode(&stored); err != nil {
+			m.logger.Errorf("Error decoding mental health log to migrate: %v", err)
+			return numMigrated, err
+		}
+
+		// a log given a time since it was read is left alone
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": stored.ID, "loggedat": nil}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"loggedat": timestamppb.New(date.ToTime(stored.LogDate, location)),
+				"timezone": location.String(),
+				"dateonly": true,
+			}}))
+		if len(models) == migrationBatchSize {
+			if err = migrate(models); err != nil {
+				m.logger.Errorf("Error migrating mental health logs: %v", err)
+				return numMigrated, err
+			}
+			models = models[:0]
+		}
+	}
+	if err = cur.Err(); err != nil {
+		return numMigrated, err
+	}
+	if err = migrate(models); err != nil {
+		m.logger.Errorf("Error migrating mental health logs: %v", err)
+		return numMigrated, err
+	}
+
+	m.logger.Infof("Migrated %v mental health logs to %v\n", numMigrated, location)
+
+	return numMigrated, nil
+}
+
+func (m *MongoRepository) GetTagUsage(ctx context.Context, userID int64) ([]*pbhealth.TagUsage, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"userid": userID, "deletedat": nil}},
+		// sorted by date first so the last log of each group is the latest
+		bson.M{"$sort": dateSort("logdate", 1)},
+		bson.M{"$unwind": "$tags"},
+		bson.M{"$group": bson.M{
+			"_id":      "$tags",
+			"count":    bson.M{"$sum": 1},
+			"lastused": bson.M{"$last": "$logdate"},
+		}},
+	}
+
+	cur, err := m.fileCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		m.logger.Errorf("Error aggregating tag usage: %v", err)
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	toReturn := make([]*pbhealth.TagUsage, 0)
+	for cur.Next(ctx) {
+		var group struct {
+			Name     string         `bson:"_id"`
+			Count    uint32         `bson:"count"`
+			LastUsed *pbcommon.Date `bson:"lastused"`
+		}
+		if err = cur.Decode(&group); err != nil {
+			m.logger.Errorf("Error decoding tag usage: %v", err)
+			return nil, err
+		}
+		toReturn = append(toReturn, &pbhealth.TagUsage{Name: group.Name, Count: group.Count, LastUsed: group.LastUsed})
+	}
+	if err = cur.Err(); err != nil {
+		return nil, err
+	}
+	sortTagUsage(toReturn)
+
+	return toReturn, nil
+}
+
+// ReplaceTags - swap sources for target on every log of the user, trash included so that restoring a log does
+// not bring an old name back. The update runs as a pipeline so each log keeps its tag order without duplicates.
+func (m *MongoRepository) ReplaceTags(ctx context.Context, userID int64, sources []string, target string) (uint32, error) {
+	// $literal keeps tag names starting with $ from being read as field paths
+	renamed := bson.M{"$map": bson.M{
+		"input": "$tags",
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{"$$this", bson.M{"$literal": sources}}},
+			bson.M{"$literal": target},
+			"$$this",
+		}},
+	}}
+	deduplicated := bson.M{"$reduce": bson.M{
+		"input":        renamed,
+		"initialValue": bson.A{},
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{"$$this", "$$value"}},
+			"$$value",
+			bson.M{"$concatArrays": bson.A{"$$value", bson.A{"$$this"}}},
+		}},
+	}}
+
+	filter := bson.M{"userid": userID, "tags": bson.M{"$in": sources}}
+	objectIDs, err := m.findLogIDs(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	// a rename is an edit like any other, so a client holding the old version cannot overwrite it unseen
+	previousLogs, updateErr := m.updateEachLog(ctx, objectIDs, filter, bson.A{bson.M{"$set": bson.M{
+		"tags":    deduplicated,
+		"version": bson.M{"$add": bson.A{"$version", 1}},
+	}}})
+	if updateErr != nil {
+		m.logger.Errorf("Error replacing tags: %v", updateErr)
+	}
+
+	for _, previous := range previousLogs {
+		tags, _ := tag.Replace(previous.Tags, sources, target)
+		err = m.recordRevisions(ctx, []*pbhealth.MentalHealthLog{previous}, &pbhealth.MentalHealthLog{Tags: tags}, []string{TagsPath})
+		if err != nil {
+			return uint32(len(previousLogs)), err
+		}
+	}
+
+	m.logger.Infof("Replaced tags on %v mental health logs for user (ID = %v)\n", len(previousLogs), userID)
+
+	return uint32(len(previousLogs)), updateErr
+}
+
+func (m *MongoRepository) DeleteUserData(ctx context.Context, userID int64) (uint32, error) {
+	res, err := m.fileCollection.DeleteMany(ctx, bson.M{"userid": userID})
+	if err != nil {
+		m.logger.Errorf("cannot delete mental health logs for user: %v \n", err)
+		return 0, err
+	}
+	numDeleted := uint32(res.DeletedCount)
+
+	if _, err = m.revisionCollection.DeleteMany(ctx, bson.M{"userid": userID}); err != nil {
+		m.logger.Errorf("cannot delete mental health log revisions for user: %v \n", err)
+		return numDeleted, err
+	}
+
+	if _, err = m.scoreCollection.DeleteOne(ctx, bson.M{"userid": userID}); err != nil {
+		m.logger.Errorf("cannot delete score aggregate for user: %v \n", err)
+		return numDeleted, err
+	}
+
+	_, err = m.grantCollection.DeleteMany(ctx, bson.M{"$or": bson.A{
+		bson.M{"ownerid": userID},
+		bson.M{"granteeid": userID},
+	}})
+	if err != nil {
+		m.logger.Errorf("cannot delete access grants for user: %v \n", err)
+	}
+
+	return numDeleted, err
+}
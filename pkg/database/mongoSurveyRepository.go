@@ -0,0 +1,159 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+const (
+	surveyCollectionName         = "surveys"
+	surveyResponseCollectionName = "surveyresponses"
+)
+
+type MongoSurveyRepository struct {
+	client                   *mongo.Client
+	surveyCollection         *mongo.Collection
+	surveyResponseCollection *mongo.Collection
+
+	logger *zap.SugaredLogger
+}
+
+func NewMongoSurveyRepository(client *mongo.Client, logger *zap.SugaredLogger) *MongoSurveyRepository {
+	return &MongoSurveyRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (m *MongoSurveyRepository) SetCollections(databaseName string) {
+	m.surveyCollection = m.client.Database(databaseName).Collection(surveyCollectionName)
+	m.surveyResponseCollection = m.client.Database(databaseName).Collection(surveyResponseCollectionName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := m.surveyCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating survey indexes: %v", err)
+	}
+
+	_, err = m.surveyResponseCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "userid", Value: 1}, {Key: "surveyid", Value: 1}},
+	})
+	if err != nil {
+		m.logger.Errorf("Error creating survey response indexes: %v", err)
+	}
+}
+
+// PublishSurvey - versions are unique per survey, so when two versions are published at once the later one fails
+// with codes.Aborted rather than replacing the other
+func (m *MongoSurveyRepository) PublishSurvey(ctx context.Context, survey *pbhealth.Survey) (int64, error) {
+	latest, err := m.GetSurvey(ctx, survey.Id, 0)
+	if status.Code(err) == codes.NotFound {
+		survey.Version = 1
+	} else if err != nil {
+		return 0, err
+	} else {
+		survey.Version = latest.Version + 1
+	}
+
+	_, err = m.surveyCollection.InsertOne(ctx, survey)
+	if mongo.IsDuplicateKeyError(err) {
+		return 0, status.Errorf(codes.Aborted, "Another version of the survey was published at the same time")
+	} else if err != nil {
+		m.logger.Errorf("Error publishing survey: %v", err)
+		return 0, err
+	}
+
+	return survey.Version, nil
+}
+
+func (m *MongoSurveyRepository) GetSurvey(ctx context.Context, id string, version int64) (*pbhealth.Survey, error) {
+	filter := bson.M{"id": id}
+	if version != 0 {
+		filter["version"] = version
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+
+	survey := &pbhealth.Survey{}
+	err := m.surveyCollection.FindOne(ctx, filter, opts).Decode(survey)
+	if err == mongo.ErrNoDocuments {
+		return nil, surveyNotFound()
+	} else if err != nil {
+		m.logger.Errorf("Error finding survey: %v", err)
+		return nil, err
+	}
+
+	return survey, nil
+}
+
+func (m *MongoSurveyRepository) AddSurveyResponse(ctx context.Context, response *pbhealth.SurveyResponse) (string, error) {
+	fields, err := documentFields(response)
+	if err != nil {
+		m.logger.Errorf("Error encoding survey response: %v", err)
+		return "", err
+	}
+
+	objectID := primitive.NewObjectID()
+	document := append(bson.D{{Key: "_id", Value: objectID}}, fields...)
+
+	if _, err = m.surveyResponseCollection.InsertOne(ctx, document); err != nil {
+		m.logger.Errorf("Error adding survey response: %v", err)
+		return "", err
+	}
+	response.Id = objectID.Hex()
+
+	return response.Id, nil
+}
+
+func (m *MongoSurveyRepository) GetSurveyResponses(ctx context.Context, userID int64, surveyID string) ([]*pbhealth.SurveyResponse, error) {
+	toReturn := make([]*pbhealth.SurveyResponse, 0)
+
+	filter := bson.M{"userid": userID}
+	if surveyID != "" {
+		filter["surveyid"] = surveyID
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "submittedat.seconds", Value: 1}, {Key: "submittedat.nanos", Value: 1}})
+
+	cur, err := m.surveyResponseCollection.Find(ctx, filter, opts)
+	if err != nil {
+		m.logger.Errorf("Error finding survey responses: %v", err)
+		return toReturn, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		response := &pbhealth.SurveyResponse{}
+		if err = cur.Decode(response); err != nil {
+			m.logger.Errorf("Error decoding survey response: %v", err)
+			return toReturn, err
+		}
+		response.Id = cur.Current.Lookup("_id").ObjectID().Hex()
+		toReturn = append(toReturn, response)
+	}
+
+	return toReturn, cur.Err()
+}
+
+func (m *MongoSurveyRepository) DeleteUserSurveyResponses(ctx context.Context, userID int64) (uint32, error) {
+	res, err := m.surveyResponseCollection.DeleteMany(ctx, bson.M{"userid": userID})
+	if err != nil {
+		m.logger.Errorf("Error deleting survey responses: %v", err)
+		return 0, err
+	}
+
+	return uint32(res.DeletedCount), nil
+}
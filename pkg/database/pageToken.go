@@ -0,0 +1,60 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbcommon "github.com/kic/health/pkg/proto/common"
+)
+
+// LogRangeQuery - parameters for fetching one page of a user's mental health logs between two dates. Deleted pages
+// through the logs in the trash instead of the live ones.
+type LogRangeQuery struct {
+	StartDate  *pbcommon.Date
+	EndDate    *pbcommon.Date
+	Descending bool
+	Deleted    bool
+	PageSize   int
+	PageToken  string
+}
+
+// pageCursor - position of the last log returned on a page, logs are ordered by date and then by ID
+type pageCursor struct {
+	Year  int32  `json:"y"`
+	Month int32  `json:"m"`
+	Day   int32  `json:"d"`
+	ID    string `json:"id"`
+}
+
+func (c *pageCursor) date() *pbcommon.Date {
+	return &pbcommon.Date{Year: c.Year, Month: c.Month, Day: c.Day}
+}
+
+// encodePageToken - turn the position of the last log on a page into an opaque token for the client
+func encodePageToken(date *pbcommon.Date, id string) string {
+	cursor := pageCursor{Year: date.Year, Month: date.Month, Day: date.Day, ID: id}
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken - parse a token made by encodePageToken, an empty token means start from the first page
+func decodePageToken(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+
+	cursor := &pageCursor{}
+	if err := json.Unmarshal(raw, cursor); err != nil || cursor.ID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token")
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// ScoreAggregate - running totals of a user's mental health log scores, overall and per mood dimension, kept up to
+// date by the repository on every write so that no score ever needs a scan of the user's logs
+type ScoreAggregate struct {
+	UserID      int64                          `bson:"userid"`
+	Sum         int64                          `bson:"sum"`
+	Count       int64                          `bson:"count"`
+	Dimensions  map[string]*DimensionAggregate `bson:"dimensions"`
+	LastUpdated time.Time                      `bson:"lastupdated"`
+}
+
+// DimensionAggregate - running totals of the scores a user gave one mood dimension
+type DimensionAggregate struct {
+	Sum   int64 `bson:"sum"`
+	Count int64 `bson:"count"`
+}
+
+// Average - the rounded mean score, or 0 when the user has no logs
+func (a *ScoreAggregate) Average() int32 {
+	if a == nil || a.Count <= 0 {
+		return 0
+	}
+	return int32(math.Round(float64(a.Sum) / float64(a.Count)))
+}
+
+// DimensionAverage - the rounded mean score of a mood dimension, and whether the user has rated it at all, as 0 is a
+// valid score for some dimensions
+func (a *ScoreAggregate) DimensionAverage(dimension string) (int32, bool) {
+	if a == nil {
+		return 0, false
+	}
+	totals, ok := a.Dimensions[dimension]
+	if !ok || totals.Count <= 0 {
+		return 0, false
+	}
+	return int32(math.Round(float64(totals.Sum) / float64(totals.Count))), true
+}
+
+// add - count a log in the totals, or take it out of them when sign is -1
+func (a *ScoreAggregate) add(healthLog *pbhealth.MentalHealthLog, sign int64) {
+	a.Sum += sign * int64(healthLog.Score)
+	a.Count += sign
+	for _, score := range healthLog.DimensionScores {
+		if a.Dimensions == nil {
+			a.Dimensions = make(map[string]*DimensionAggregate)
+		}
+		totals, ok := a.Dimensions[score.Dimension]
+		if !ok {
+			totals = &DimensionAggregate{}
+			a.Dimensions[score.Dimension] = totals
+		}
+		totals.Sum += sign * int64(score.Score)
+		totals.Count += sign
+	}
+}
+
+// isZero - whether applying the totals as a change would leave an aggregate as it was
+func (a *ScoreAggregate) isZero() bool {
+	if a.Sum != 0 || a.Count != 0 {
+		return false
+	}
+	for _, totals := range a.Dimensions {
+		if totals.Sum != 0 || totals.Count != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// aggregateDelta - the change to a user's running totals when the added logs are counted and the removed ones are
+// taken out
+func aggregateDelta(added []*pbhealth.MentalHealthLog, removed []*pbhealth.MentalHealthLog) *ScoreAggregate {
+	toReturn := &ScoreAggregate{}
+	for _, healthLog := range added {
+		toReturn.add(healthLog, 1)
+	}
+	for _, healthLog := range removed {
+		toReturn.add(healthLog, -1)
+	}
+	return toReturn
+}
+
+// updateDelta - the change to a user's running totals when an update replaces the previous versions of logs
+func updateDelta(previous []*pbhealth.MentalHealthLog, update *pbhealth.MentalHealthLog, paths []string) *ScoreAggregate {
+	updated := make([]*pbhealth.MentalHealthLog, 0, len(previous))
+	for _, healthLog := range previous {
+		healthLog = proto.Clone(healthLog).(*pbhealth.MentalHealthLog)
+		applyLogUpdate(healthLog, update, paths)
+		updated = append(updated, healthLog)
+	}
+	return aggregateDelta(updated, previous)
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"sort"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// sortTagUsage - most used tags first, ties broken by name so the order is stable
+func sortTagUsage(usage []*pbhealth.TagUsage) {
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Count != usage[j].Count {
+			return usage[i].Count > usage[j].Count
+		}
+		return usage[i].Name < usage[j].Name
+	})
+}
@@ -0,0 +1,171 @@
+package date
+
+import (
+	"fmt"
+	"time"
+
+	pbcommon "github.com/kic/health/pkg/proto/common"
+)
+
+// Layout - the ISO 8601 calendar date layout dates are formatted and parsed with
+const Layout = "2006-01-02"
+
+// the range of years a date can have, which keeps every date four digits long when formatted
+const (
+	MinYear = 1
+	MaxYear = 9999
+)
+
+// New - the date of a year, month and day, which are not checked
+func New(year int, month int, day int) *pbcommon.Date {
+	return &pbcommon.Date{Year: int32(year), Month: int32(month), Day: int32(day)}
+}
+
+// Validate - check that date is a day of the calendar
+func Validate(date *pbcommon.Date) error {
+	if date == nil {
+		return fmt.Errorf("date is required")
+	}
+	if date.Year < MinYear || date.Year > MaxYear {
+		return fmt.Errorf("year must be from %v to %v, got %v", MinYear, MaxYear, date.Year)
+	}
+	if date.Month < 1 || date.Month > 12 {
+		return fmt.Errorf("month must be from 1 to 12, got %v", date.Month)
+	}
+	if days := DaysIn(date.Year, date.Month); date.Day < 1 || date.Day > days {
+		return fmt.Errorf("day must be from 1 to %v in %04d-%02d, got %v", days, date.Year, date.Month, date.Day)
+	}
+	return nil
+}
+
+// IsValid - whether date is a day of the calendar
+func IsValid(date *pbcommon.Date) bool {
+	return Validate(date) == nil
+}
+
+// DaysIn - the number of days in a month of a year
+func DaysIn(year int32, month int32) int32 {
+	// day 0 of the next month is the last day of this one
+	return int32(time.Date(int(year), time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day())
+}
+
+// ToTime - the start of date in location. Dates that are not valid are normalized the way time.Date does.
+func ToTime(date *pbcommon.Date, location *time.Location) time.Time {
+	return time.Date(int(date.Year), time.Month(date.Month), int(date.Day), 0, 0, 0, 0, location)
+}
+
+// FromTime - the date of t in its own location
+func FromTime(t time.Time) *pbcommon.Date {
+	return New(t.Year(), int(t.Month()), t.Day())
+}
+
+// Format - date in the Layout, or the empty string for a nil date
+func Format(date *pbcommon.Date) string {
+	if date == nil {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
+
+// Parse - the date written in the Layout
+func Parse(value string) (*pbcommon.Date, error) {
+	t, err := time.Parse(Layout, value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read date %q", value)
+	}
+	return FromTime(t), nil
+}
+
+// Compare - returns -1, 0 or 1 depending on whether a is before, the same as, or after b
+func Compare(a *pbcommon.Date, b *pbcommon.Date) int {
+	switch {
+	case a.Year != b.Year:
+		return sign(a.Year - b.Year)
+	case a.Month != b.Month:
+		return sign(a.Month - b.Month)
+	default:
+		return sign(a.Day - b.Day)
+	}
+}
+
+// Equal - whether a and b are the same day
+func Equal(a *pbcommon.Date, b *pbcommon.Date) bool {
+	return Compare(a, b) == 0
+}
+
+// Before - whether a is a day before b
+func Before(a *pbcommon.Date, b *pbcommon.Date) bool {
+	return Compare(a, b) < 0
+}
+
+// After - whether a is a day after b
+func After(a *pbcommon.Date, b *pbcommon.Date) bool {
+	return Compare(a, b) > 0
+}
+
+// AddDays - the date days after date, or before it if days is negative
+func AddDays(date *pbcommon.Date, days int) *pbcommon.Date {
+	return FromTime(ToTime(date, time.UTC).AddDate(0, 0, days))
+}
+
+// AddMonths - the same day months after date, or before it if months is negative. Days past the end of the
+// resulting month are moved back to its last day, so January 31st plus one month is the end of February.
+func AddMonths(date *pbcommon.Date, months int) *pbcommon.Date {
+	first := ToTime(&pbcommon.Date{Year: date.Year, Month: date.Month, Day: 1}, time.UTC).AddDate(0, months, 0)
+	day := date.Day
+	if days := DaysIn(int32(first.Year()), int32(first.Month())); day > days {
+		day = days
+	}
+	return New(first.Year(), int(first.Month()), int(day))
+}
+
+// DaysBetween - the number of days from a to b, negative if b is before a
+func DaysBetween(a *pbcommon.Date, b *pbcommon.Date) int {
+	// whole days in UTC, where every day is 24 hours long
+	return int(ToTime(b, time.UTC).Sub(ToTime(a, time.UTC)).Hours() / 24)
+}
+
+// ISOWeek - the ISO 8601 year and week number of date. Weeks start on Monday and the first week of a year is the
+// one with its first Thursday.
+func ISOWeek(date *pbcommon.Date) (year int, week int) {
+	return ToTime(date, time.UTC).ISOWeek()
+}
+
+// StartOfISOWeek - the Monday of the week of date
+func StartOfISOWeek(date *pbcommon.Date) *pbcommon.Date {
+	t := ToTime(date, time.UTC)
+	return FromTime(t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7)))
+}
+
+// EndOfISOWeek - the Sunday of the week of date
+func EndOfISOWeek(date *pbcommon.Date) *pbcommon.Date {
+	return AddDays(StartOfISOWeek(date), 6)
+}
+
+// StartOfMonth - the first day of the month of date
+func StartOfMonth(date *pbcommon.Date) *pbcommon.Date {
+	return &pbcommon.Date{Year: date.Year, Month: date.Month, Day: 1}
+}
+
+// EndOfMonth - the last day of the month of date
+func EndOfMonth(date *pbcommon.Date) *pbcommon.Date {
+	return &pbcommon.Date{Year: date.Year, Month: date.Month, Day: DaysIn(date.Year, date.Month)}
+}
+
+// ForEach - call fn with every day from start to end, inclusive, in order, stopping early when it returns false
+func ForEach(start *pbcommon.Date, end *pbcommon.Date, fn func(date *pbcommon.Date) bool) {
+	for day := ToTime(start, time.UTC); !day.After(ToTime(end, time.UTC)); day = day.AddDate(0, 0, 1) {
+		if !fn(FromTime(day)) {
+			return
+		}
+	}
+}
+
+func sign(x int32) int {
+	if x < 0 {
+		return -1
+	} else if x > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,59 @@
+package date
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbcommon "github.com/kic/health/pkg/proto/common"
+)
+
+// ValidateFields - check every date set in message, however deeply nested, naming the field of the first one that
+// is not valid. Dates that are not set are left to the caller to require.
+func ValidateFields(message proto.Message) error {
+	if message == nil {
+		return nil
+	}
+	return validateFields(message.ProtoReflect(), "")
+}
+
+func validateFields(message protoreflect.Message, prefix string) error {
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Message() == nil {
+			return true
+		}
+		path := prefix + field.JSONName()
+
+		switch {
+		case field.IsMap():
+			if field.MapValue().Message() == nil {
+				return true
+			}
+			value.Map().Range(func(key protoreflect.MapKey, entry protoreflect.Value) bool {
+				err = validateValue(entry.Message(), fmt.Sprintf("%v[%v]", path, key.Interface()))
+				return err == nil
+			})
+		case field.IsList():
+			for i := 0; i < value.List().Len() && err == nil; i++ {
+				err = validateValue(value.List().Get(i).Message(), fmt.Sprintf("%v[%v]", path, i))
+			}
+		default:
+			err = validateValue(value.Message(), path)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func validateValue(message protoreflect.Message, path string) error {
+	date, ok := message.Interface().(*pbcommon.Date)
+	if !ok {
+		return validateFields(message, path+".")
+	}
+	if err := Validate(date); err != nil {
+		return fmt.Errorf("%v: %v", path, err)
+	}
+	return nil
+}
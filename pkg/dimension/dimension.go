@@ -0,0 +1,60 @@
+package dimension
+
+import (
+	"fmt"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// the mood dimensions a log can rate, in the order they are listed
+var dimensions = []*pbhealth.MoodDimension{
+	{Name: "energy", Description: "How energetic the day felt, from drained to full of energy", MinScore: 1, MaxScore: 5},
+	{Name: "anxiety", Description: "How anxious the day felt, from calm to overwhelmed", MinScore: 0, MaxScore: 10},
+	{Name: "stress", Description: "How stressful the day felt, from relaxed to overwhelmed", MinScore: 0, MaxScore: 10},
+	{Name: "sleepQuality", Description: "How well the night before went, from very poorly to very well", MinScore: 1, MaxScore: 5},
+}
+
+// All - every mood dimension, in the order they are listed
+func All() []*pbhealth.MoodDimension {
+	return dimensions
+}
+
+// Get - the mood dimension with a name
+func Get(name string) (*pbhealth.MoodDimension, bool) {
+	for _, dimension := range dimensions {
+		if dimension.Name == name {
+			return dimension, true
+		}
+	}
+	return nil, false
+}
+
+// Validate - check that every score rates a known dimension, within its range, at most once
+func Validate(scores []*pbhealth.DimensionScore) error {
+	seen := make(map[string]bool, len(scores))
+	for _, score := range scores {
+		dimension, ok := Get(score.GetDimension())
+		if !ok {
+			return fmt.Errorf("unknown mood dimension %q", score.GetDimension())
+		}
+		if seen[dimension.Name] {
+			return fmt.Errorf("mood dimension %q is rated more than once", dimension.Name)
+		}
+		seen[dimension.Name] = true
+
+		if score.Score < dimension.MinScore || score.Score > dimension.MaxScore {
+			return fmt.Errorf("%v score must be from %v to %v", dimension.Name, dimension.MinScore, dimension.MaxScore)
+		}
+	}
+	return nil
+}
+
+// Score - the score a log gives a dimension, and whether it rates it at all
+func Score(log *pbhealth.MentalHealthLog, name string) (int32, bool) {
+	for _, score := range log.GetDimensionScores() {
+		if score.Dimension == name {
+			return score.Score, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,149 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// KeySize - size in bytes of master and data keys, selecting AES-256
+	KeySize = 32
+
+	// prefix marking a field value as ciphertext, so plaintext written before encryption was enabled still reads
+	fieldPrefix = "enc:v1:"
+)
+
+// MasterKey - the key that wraps every user's data key, identified so wrapped keys can be matched to the master
+// key they need
+type MasterKey struct {
+	ID  string
+	key []byte
+}
+
+// NewMasterKey - a master key from raw key material
+func NewMasterKey(id string, key []byte) (*MasterKey, error) {
+	if id == "" {
+		return nil, errors.New("master key ID is required")
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("master key must be %v bytes, got %v", KeySize, len(key))
+	}
+	return &MasterKey{ID: id, key: key}, nil
+}
+
+// ParseMasterKey - a master key from base64 encoded key material, as it is kept in config
+func ParseMasterKey(id string, encoded string) (*MasterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("master key is not valid base64: %v", err)
+	}
+	return NewMasterKey(id, key)
+}
+
+// Wrap - encrypt the data key of the given user under the master key
+func (m *MasterKey) Wrap(dataKey []byte, userID int64) ([]byte, error) {
+	return seal(m.key, dataKey, userAssociatedData(userID))
+}
+
+// Unwrap - decrypt the data key of the given user, failing if it was wrapped by another key or for another user
+func (m *MasterKey) Unwrap(wrappedKey []byte, userID int64) ([]byte, error) {
+	return open(m.key, wrappedKey, userAssociatedData(userID))
+}
+
+// NewDataKey - fresh random key material for a user's data key
+func NewDataKey() ([]byte, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	return dataKey, nil
+}
+
+// EncryptField - encrypt a field value of the given user with their data key. Empty values are left empty.
+func EncryptField(dataKey []byte, value string, userID int64) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	sealed, err := seal(dataKey, []byte(value), userAssociatedData(userID))
+	if err != nil {
+		return "", err
+	}
+	return fieldPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptField - decrypt a field value of the given user with their data key. Values that were never encrypted
+// are returned as they are.
+func DecryptField(dataKey []byte, value string, userID int64) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(value[len(fieldPrefix):])
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataKey, sealed, userAssociatedData(userID))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted - whether a field value holds ciphertext
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, fieldPrefix)
+}
+
+// userAssociatedData - binds ciphertext to its user, so it cannot be moved onto another user's records
+func userAssociatedData(userID int64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(userID))
+	return data
+}
+
+// seal - AES-GCM encrypt plaintext, returning the nonce followed by the ciphertext
+func seal(key []byte, plaintext []byte, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// open - AES-GCM decrypt the output of seal
+func open(key []byte, sealed []byte, associatedData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, associatedData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
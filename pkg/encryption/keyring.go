@@ -0,0 +1,63 @@
+package encryption
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Keyring - the current master key, which wraps new data keys, along with earlier master keys that data keys
+// may still be wrapped by until they are rotated
+type Keyring struct {
+	current *MasterKey
+	keys    map[string]*MasterKey
+}
+
+func NewKeyring(current *MasterKey, previous ...*MasterKey) *Keyring {
+	keys := map[string]*MasterKey{current.ID: current}
+	for _, key := range previous {
+		if _, ok := keys[key.ID]; !ok {
+			keys[key.ID] = key
+		}
+	}
+	return &Keyring{current: current, keys: keys}
+}
+
+// ParseKeyring - a keyring from config: the current master key ID and base64 key material, and the earlier master
+// keys as a comma separated list of id:key pairs
+func ParseKeyring(currentID string, currentKey string, previousKeys string) (*Keyring, error) {
+	current, err := ParseMasterKey(currentID, currentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make([]*MasterKey, 0)
+	for _, pair := range strings.Split(previousKeys, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("previous master key %q must be id:key", pair)
+		}
+
+		key, err := ParseMasterKey(parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, key)
+	}
+
+	return NewKeyring(current, previous...), nil
+}
+
+// Current - the master key new data keys are wrapped by
+func (k *Keyring) Current() *MasterKey {
+	return k.current
+}
+
+// Get - the master key with the given ID, if it is on the keyring
+func (k *Keyring) Get(id string) (*MasterKey, bool) {
+	key, ok := k.keys[id]
+	return key, ok
+}
@@ -0,0 +1,216 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/logtime"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// Names of the record types in an export
+const (
+	MentalHealthLogs        = "mentalHealthLogs"
+	DeletedMentalHealthLogs = "deletedMentalHealthLogs"
+	HealthLogRevisions      = "healthLogRevisions"
+	AccessGrants            = "accessGrants"
+	Assessments             = "assessments"
+	SurveyResponses         = "surveyResponses"
+)
+
+// recordType - how records of one type are described and laid out as CSV rows
+type recordType struct {
+	description string
+	columns     []string
+	row         func(proto.Message) []string
+}
+
+var recordTypes = map[string]*recordType{
+	MentalHealthLogs: {
+		description: "Mental health log entries",
+		columns:     []string{"id", "logDate", "score", "journalName", "version", "dimensionScores", "tags", "loggedAt", "timeZone"},
+		row: func(message proto.Message) []string {
+			healthLog := message.(*pbhealth.MentalHealthLog)
+			return []string{healthLog.Id, date.Format(healthLog.LogDate), formatInt(int64(healthLog.Score)), healthLog.JournalName, formatInt(healthLog.Version), formatDimensionScores(healthLog.DimensionScores), strings.Join(healthLog.Tags, ";"), formatLoggedAt(healthLog), healthLog.TimeZone}
+		},
+	},
+	DeletedMentalHealthLogs: {
+		description: "Mental health log entries in the trash, not yet purged",
+		columns:     []string{"id", "logDate", "score", "journalName", "version", "dimensionScores", "tags", "loggedAt", "timeZone", "deletedAt"},
+		row: func(message proto.Message) []string {
+			healthLog := message.(*pbhealth.MentalHealthLog)
+			return []string{healthLog.Id, date.Format(healthLog.LogDate), formatInt(int64(healthLog.Score)), healthLog.JournalName, formatInt(healthLog.Version), formatDimensionScores(healthLog.DimensionScores), strings.Join(healthLog.Tags, ";"), formatLoggedAt(healthLog), healthLog.TimeZone, formatTime(healthLog.DeletedAt)}
+		},
+	},
+	HealthLogRevisions: {
+		description: "Earlier revisions of edited mental health log entries, including those in the trash",
+		columns:     []string{"logID", "version", "revisedAt", "changedFields", "logDate", "score", "journalName", "dimensionScores", "tags"},
+		row: func(message proto.Message) []string {
+			revision := message.(*pbhealth.HealthLogRevision)
+			healthLog := revision.HealthLog
+			return []string{revision.LogID, formatInt(revision.Version), formatTime(revision.RevisedAt), strings.Join(revision.ChangedFields, ";"), date.Format(healthLog.GetLogDate()), formatInt(int64(healthLog.GetScore())), healthLog.GetJournalName(), formatDimensionScores(healthLog.GetDimensionScores()), strings.Join(healthLog.GetTags(), ";")}
+		},
+	},
+	AccessGrants: {
+		description: "Access to health data given to and received from other users",
+		columns:     []string{"ownerID", "granteeID", "scope", "createdAt", "expiresAt"},
+		row: func(message proto.Message) []string {
+			grant := message.(*pbhealth.AccessGrant)
+			return []string{formatInt(grant.OwnerID), formatInt(grant.GranteeID), grant.Scope.String(), formatTime(grant.CreatedAt), formatTime(grant.ExpiresAt)}
+		},
+	},
+	Assessments: {
+		description: "PHQ-9 and GAD-7 assessments with their scores",
+		columns:     []string{"id", "type", "assessmentDate", "submittedAt", "responses", "totalScore", "severity", "riskFlagged"},
+		row: func(message proto.Message) []string {
+			assessment := message.(*pbhealth.Assessment)
+			responses := make([]string, 0, len(assessment.Responses))
+			for _, response := range assessment.Responses {
+				responses = append(responses, formatInt(int64(response)))
+			}
+			return []string{assessment.Id, assessment.Type.String(), date.Format(assessment.AssessmentDate), formatTime(assessment.SubmittedAt), strings.Join(responses, ";"), formatInt(int64(assessment.TotalScore)), assessment.Severity.String(), strconv.FormatBool(assessment.RiskFlagged)}
+		},
+	},
+	SurveyResponses: {
+		description: "Answers to surveys and their scores. Answers are a JSON array, as they can hold free text.",
+		columns:     []string{"id", "surveyID", "surveyVersion", "submittedAt", "answers", "scores"},
+		row: func(message proto.Message) []string {
+			response := message.(*pbhealth.SurveyResponse)
+			scores := make([]string, 0, len(response.Scores))
+			for _, score := range response.Scores {
+				scores = append(scores, fmt.Sprintf("%v=%v", score.Name, score.Value))
+			}
+			return []string{response.Id, response.SurveyID, formatInt(response.SurveyVersion), formatTime(response.SubmittedAt), formatAnswers(response.Answers), strings.Join(scores, ";")}
+		},
+	},
+}
+
+// RecordTypes - the record types of an export, in the order their first chunk is streamed. Revisions are streamed
+// after each page of logs they belong to, so their chunks are interleaved with those of the logs.
+func RecordTypes() []*pbhealth.ExportRecordType {
+	toReturn := make([]*pbhealth.ExportRecordType, 0, len(recordTypes))
+	for _, name := range []string{MentalHealthLogs, HealthLogRevisions, DeletedMentalHealthLogs, AccessGrants, Assessments, SurveyResponses} {
+		toReturn = append(toReturn, &pbhealth.ExportRecordType{
+			Name:        name,
+			Description: recordTypes[name].description,
+			Columns:     recordTypes[name].columns,
+		})
+	}
+	return toReturn
+}
+
+// Encoder - encodes records of one type in an export format, one chunk at a time. CSV exports get a header row at the
+// start of the first chunk.
+type Encoder struct {
+	format      pbhealth.ExportFormat
+	recordType  *recordType
+	wroteHeader bool
+}
+
+func NewEncoder(format pbhealth.ExportFormat, name string) (*Encoder, error) {
+	recordType, ok := recordTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown record type %q", name)
+	}
+	if _, ok = pbhealth.ExportFormat_name[int32(format)]; !ok {
+		return nil, fmt.Errorf("unknown export format %v", format)
+	}
+
+	return &Encoder{format: format, recordType: recordType}, nil
+}
+
+// Encode - the encoded records, ready to be sent as one chunk
+func (e *Encoder) Encode(records []proto.Message) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	switch e.format {
+	case pbhealth.ExportFormat_CSV:
+		writer := csv.NewWriter(buf)
+		if !e.wroteHeader {
+			if err := writer.Write(e.recordType.columns); err != nil {
+				return nil, err
+			}
+			e.wroteHeader = true
+		}
+		for _, record := range records {
+			if err := writer.Write(e.recordType.row(record)); err != nil {
+				return nil, err
+			}
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, err
+		}
+	default:
+		for _, record := range records {
+			line, err := protojson.Marshal(record)
+			if err != nil {
+				return nil, err
+			}
+			// protojson output is not stable, compacting keeps every record on one predictable line
+			if err = json.Compact(buf, line); err != nil {
+				return nil, err
+			}
+			buf.WriteByte('\n')
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatLoggedAt - when a log was made, in its own time zone, or nothing when only its date is known
+func formatLoggedAt(healthLog *pbhealth.MentalHealthLog) string {
+	local, ok := logtime.LocalTime(healthLog)
+	if !ok {
+		return ""
+	}
+	return local.Format(time.RFC3339)
+}
+
+func formatTime(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().Format(time.RFC3339)
+}
+
+// formatAnswers - survey answers as a compact JSON array
+func formatAnswers(answers []*pbhealth.SurveyAnswer) string {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('[')
+	for i, answer := range answers {
+		line, err := protojson.Marshal(answer)
+		if err != nil {
+			continue
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		json.Compact(buf, line)
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// formatDimensionScores - dimension scores as name=score pairs separated by semicolons
+func formatDimensionScores(scores []*pbhealth.DimensionScore) string {
+	pairs := make([]string, 0, len(scores))
+	for _, score := range scores {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", score.Dimension, score.Score))
+	}
+	return strings.Join(pairs, ";")
+}
+
+func formatInt(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
@@ -0,0 +1,205 @@
+package fhir
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	"github.com/kic/health/pkg/logtime"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/score"
+)
+
+// Code systems used by the resources
+const (
+	ObservationCategorySystem = "http://terminology.hl7.org/CodeSystem/observation-category"
+	UCUMSystem                = "http://unitsofmeasure.org"
+	// CodeSystem - local codes for the concepts LOINC has no code for
+	CodeSystem = "https://keeping-it-casual.com/fhir/CodeSystem/health"
+)
+
+// Local codes of the observations
+const (
+	MoodScoreCode      = "mood-score"
+	MeanMoodScoreCode  = "mean-mood-score"
+	MoodScoreCountCode = "mood-score-count"
+)
+
+// Bundle - a FHIR R4 Bundle resource
+type Bundle struct {
+	ResourceType string         `json:"resourceType"`
+	Type         string         `json:"type"`
+	Timestamp    string         `json:"timestamp"`
+	Entry        []*BundleEntry `json:"entry"`
+}
+
+type BundleEntry struct {
+	Resource *Observation `json:"resource"`
+}
+
+// Observation - a FHIR R4 Observation resource, with the elements used for mood scores
+type Observation struct {
+	ResourceType      string                  `json:"resourceType"`
+	ID                string                  `json:"id,omitempty"`
+	Status            string                  `json:"status"`
+	Category          []*CodeableConcept      `json:"category"`
+	Code              *CodeableConcept        `json:"code"`
+	Subject           *Reference              `json:"subject"`
+	EffectiveDateTime string                  `json:"effectiveDateTime,omitempty"`
+	EffectivePeriod   *Period                 `json:"effectivePeriod,omitempty"`
+	ValueQuantity     *Quantity               `json:"valueQuantity,omitempty"`
+	ReferenceRange    []*ReferenceRange       `json:"referenceRange,omitempty"`
+	Note              []*Annotation           `json:"note,omitempty"`
+	Component         []*ObservationComponent `json:"component,omitempty"`
+}
+
+type ObservationComponent struct {
+	Code          *CodeableConcept `json:"code"`
+	ValueQuantity *Quantity        `json:"valueQuantity,omitempty"`
+	ValueInteger  *int64           `json:"valueInteger,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []*Coding `json:"coding"`
+	Text   string    `json:"text,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system"`
+	Code    string `json:"code"`
+	Display string `json:"display,omitempty"`
+}
+
+type Reference struct {
+	Reference string `json:"reference"`
+}
+
+type Period struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type Quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+type ReferenceRange struct {
+	Low  *Quantity `json:"low"`
+	High *Quantity `json:"high"`
+	Text string    `json:"text,omitempty"`
+}
+
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+// NewBundle - a collection Bundle of the observations
+func NewBundle(observations ...*Observation) *Bundle {
+	entries := make([]*BundleEntry, 0, len(observations))
+	for _, observation := range observations {
+		entries = append(entries, &BundleEntry{Resource: observation})
+	}
+
+	return &Bundle{
+		ResourceType: "Bundle",
+		Type:         "collection",
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Entry:        entries,
+	}
+}
+
+// NewObservation - the Observation of the score of a log. The journal is added as a note if includeJournal is set.
+func NewObservation(healthLog *pbhealth.MentalHealthLog, includeJournal bool) *Observation {
+	observation := newScoreObservation(healthLog.UserID, MoodScoreCode, "Mood score")
+	observation.ID = "mood-" + healthLog.Id
+	// an entry written more than once has been edited since it was first recorded
+	if healthLog.Version > 1 {
+		observation.Status = "amended"
+	}
+	observation.EffectiveDateTime = effectiveDateTime(healthLog)
+	observation.ValueQuantity = scoreQuantity(float64(healthLog.Score))
+
+	if includeJournal && healthLog.JournalName != "" {
+		observation.Note = []*Annotation{{Text: healthLog.JournalName}}
+	}
+
+	return observation
+}
+
+// NewMeanScoreObservation - the Observation of the mean score of the logs over the period they cover, or nil if
+// there are none
+func NewMeanScoreObservation(userID int64, logs []*pbhealth.MentalHealthLog) *Observation {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	var sum int64
+	first, last := logs[0].LogDate, logs[0].LogDate
+	for _, healthLog := range logs {
+		sum += int64(healthLog.Score)
+		if date.Before(healthLog.LogDate, first) {
+			first = healthLog.LogDate
+		}
+		if date.After(healthLog.LogDate, last) {
+			last = healthLog.LogDate
+		}
+	}
+	count := int64(len(logs))
+
+	observation := newScoreObservation(userID, MeanMoodScoreCode, "Mean mood score")
+	observation.ID = fmt.Sprintf("mean-mood-%v-%v-%v", userID, date.Format(first), date.Format(last))
+	observation.EffectivePeriod = &Period{Start: date.Format(first), End: date.Format(last)}
+	observation.ValueQuantity = scoreQuantity(math.Round(float64(sum)/float64(count)*100) / 100)
+	observation.Component = []*ObservationComponent{
+		{
+			Code:         localConcept(MoodScoreCountCode, "Number of mood scores"),
+			ValueInteger: &count,
+		},
+	}
+
+	return observation
+}
+
+// effectiveDateTime - when a log was made, with the offset of its time zone, or only its date when the time is not
+// known
+func effectiveDateTime(healthLog *pbhealth.MentalHealthLog) string {
+	local, ok := logtime.LocalTime(healthLog)
+	if !ok {
+		return date.Format(healthLog.LogDate)
+	}
+	return local.Format(time.RFC3339)
+}
+
+func newScoreObservation(userID int64, code string, display string) *Observation {
+	return &Observation{
+		ResourceType: "Observation",
+		Status:       "final",
+		Category: []*CodeableConcept{
+			{Coding: []*Coding{{System: ObservationCategorySystem, Code: "survey", Display: "Survey"}}},
+		},
+		Code:    localConcept(code, display),
+		Subject: &Reference{Reference: fmt.Sprintf("Patient/%v", userID)},
+		ReferenceRange: []*ReferenceRange{
+			{
+				Low:  scoreQuantity(score.Min),
+				High: scoreQuantity(score.Max),
+				Text: "Self reported, from very low to very high mood",
+			},
+		},
+	}
+}
+
+func localConcept(code string, display string) *CodeableConcept {
+	return &CodeableConcept{
+		Coding: []*Coding{{System: CodeSystem, Code: code, Display: display}},
+		Text:   display,
+	}
+}
+
+func scoreQuantity(value float64) *Quantity {
+	return &Quantity{Value: value, Unit: "score", System: UCUMSystem, Code: "{score}"}
+}
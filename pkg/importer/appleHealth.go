@@ -0,0 +1,85 @@
+package importer
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// the layout of dates in Apple Health's export.xml
+const appleHealthDateLayout = "2006-01-02 15:04:05 -0700"
+
+func init() {
+	Register(&Format{
+		Name:        "applehealth",
+		Description: "Apple Health export.xml. State of Mind samples have a valence from -1 (very unpleasant) to 1 (very pleasant), which is spread over the score range, and their labels become the journal. Every other record is skipped.",
+		Parse:       parseAppleHealth,
+	})
+}
+
+// appleStateOfMind - a State of Mind sample of an Apple Health export
+type appleStateOfMind struct {
+	StartDate string `xml:"startDate,attr"`
+	Valence   string `xml:"valence,attr"`
+	Labels    []struct {
+		Value string `xml:"value,attr"`
+	} `xml:"Label"`
+}
+
+// parseAppleHealth - exports are often hundreds of megabytes, so only State of Mind elements are decoded
+func parseAppleHealth(r io.Reader) ([]*Record, error) {
+	decoder := xml.NewDecoder(r)
+	toReturn := make([]*Record, 0)
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "StateOfMind" {
+			continue
+		}
+		row := len(toReturn)
+
+		sample := &appleStateOfMind{}
+		if err = decoder.DecodeElement(sample, &start); err != nil {
+			return nil, err
+		}
+
+		// the date is kept in the zone the sample was recorded in
+		startDate, err := time.Parse(appleHealthDateLayout, sample.StartDate)
+		if err != nil {
+			toReturn = append(toReturn, invalid(row, "cannot read date %q", sample.StartDate))
+			continue
+		}
+		valence, err := strconv.ParseFloat(sample.Valence, 64)
+		if err != nil || valence < -1 || valence > 1 {
+			toReturn = append(toReturn, invalid(row, "valence must be a number from -1 to 1"))
+			continue
+		}
+
+		journal := ""
+		for i, label := range sample.Labels {
+			if i > 0 {
+				journal += ", "
+			}
+			journal += label.Value
+		}
+
+		toReturn = append(toReturn, &Record{HealthLog: &pbhealth.MentalHealthLog{
+			LogDate:     date.New(startDate.Year(), int(startDate.Month()), startDate.Day()),
+			Score:       scaleScore(valence, -1, 1),
+			JournalName: journal,
+		}})
+	}
+
+	return toReturn, nil
+}
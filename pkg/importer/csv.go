@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvRows - the rows of a CSV export after the header, numbered from 0, as maps from lower case column names to values
+func csvRows(r io.Reader, requiredColumns ...string) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("export is empty")
+	} else if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(header))
+	for _, column := range header {
+		// exports saved by spreadsheet apps can start with a byte order mark
+		columns = append(columns, strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))))
+	}
+	for _, required := range requiredColumns {
+		if !containsColumn(columns, required) {
+			return nil, fmt.Errorf("export has no %q column", required)
+		}
+	}
+
+	rows := make([]map[string]string, 0)
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]string, len(columns))
+		for i, value := range values {
+			if i < len(columns) {
+				row[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
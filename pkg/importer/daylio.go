@@ -0,0 +1,76 @@
+package importer
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// Daylio's default moods, from worst to best. Renamed or custom moods cannot be mapped and are reported as invalid.
+var daylioMoods = []string{"awful", "bad", "meh", "good", "rad"}
+
+func init() {
+	Register(&Format{
+		Name:        "daylio",
+		Description: "Daylio CSV export. The five default moods from awful to rad are spread over the score range, activities become tags and notes become the journal.",
+		Parse:       parseDaylio,
+	})
+}
+
+func parseDaylio(r io.Reader) ([]*Record, error) {
+	rows, err := csvRows(r, "full_date", "mood")
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := make([]*Record, 0, len(rows))
+	for i, row := range rows {
+		logDate, err := time.Parse("2006-01-02", row["full_date"])
+		if err != nil {
+			toReturn = append(toReturn, invalid(i, "cannot read date %q", row["full_date"]))
+			continue
+		}
+
+		mood := -1
+		for level, name := range daylioMoods {
+			if strings.EqualFold(row["mood"], name) {
+				mood = level
+			}
+		}
+		if mood < 0 {
+			toReturn = append(toReturn, invalid(i, "unknown mood %q", row["mood"]))
+			continue
+		}
+
+		journal := row["note"]
+		if title := row["note_title"]; title != "" {
+			journal = strings.TrimSpace(title + "\n" + journal)
+		}
+
+		toReturn = append(toReturn, &Record{HealthLog: &pbhealth.MentalHealthLog{
+			LogDate:     date.New(logDate.Year(), int(logDate.Month()), logDate.Day()),
+			Score:       scaleScore(float64(mood), 0, float64(len(daylioMoods)-1)),
+			JournalName: journal,
+			Tags:        daylioActivities(row["activities"]),
+		}})
+	}
+
+	return toReturn, nil
+}
+
+// daylioActivities - the activities of an entry, which Daylio separates with " | "
+func daylioActivities(activities string) []string {
+	tags := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, activity := range strings.Split(activities, "|") {
+		activity = strings.TrimSpace(activity)
+		if activity != "" && !seen[activity] {
+			seen[activity] = true
+			tags = append(tags, activity)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kic/health/pkg/date"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// eMoods rates depressed and elevated mood separately, each from 0 (none) to 3 (severe)
+const emoodsMaxRating = 3
+
+// date layouts used by eMoods exports
+var emoodsDateLayouts = []string{"2006-01-02", "1/2/2006", "01/02/2006"}
+
+func init() {
+	Register(&Format{
+		Name:        "emoods",
+		Description: "eMoods CSV export. The elevated rating minus the depressed rating, from -3 to 3, is spread over the score range, and notes become the journal.",
+		Parse:       parseEmoods,
+	})
+}
+
+func parseEmoods(r io.Reader) ([]*Record, error) {
+	rows, err := csvRows(r, "date", "depressed", "elevated")
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := make([]*Record, 0, len(rows))
+	for i, row := range rows {
+		var logDate time.Time
+		for _, layout := range emoodsDateLayouts {
+			if logDate, err = time.Parse(layout, row["date"]); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			toReturn = append(toReturn, invalid(i, "cannot read date %q", row["date"]))
+			continue
+		}
+
+		depressed, depressedErr := strconv.Atoi(row["depressed"])
+		elevated, elevatedErr := strconv.Atoi(row["elevated"])
+		if depressedErr != nil || elevatedErr != nil || depressed < 0 || depressed > emoodsMaxRating ||
+			elevated < 0 || elevated > emoodsMaxRating {
+			toReturn = append(toReturn, invalid(i, "depressed and elevated must be ratings from 0 to %v", emoodsMaxRating))
+			continue
+		}
+
+		toReturn = append(toReturn, &Record{HealthLog: &pbhealth.MentalHealthLog{
+			LogDate:     date.New(logDate.Year(), int(logDate.Month()), logDate.Day()),
+			Score:       scaleScore(float64(elevated-depressed), -emoodsMaxRating, emoodsMaxRating),
+			JournalName: row["notes"],
+		}})
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+	"github.com/kic/health/pkg/score"
+)
+
+// MaxExportSize - the largest export in bytes that can be imported. An export is sent to the server in a single
+// message, so the server's gRPC receive limit is raised to fit it and larger exports have to be split before importing.
+const MaxExportSize = 64 << 20
+
+// Record - one row of an export, either parsed into a log without an owner or with the reason it could not be
+type Record struct {
+	HealthLog *pbhealth.MentalHealthLog
+	Err       error
+}
+
+// Format - a third party export format
+type Format struct {
+	Name        string
+	Description string
+	// Parse - the records of an export, in the order they appear. An error is only returned if the export as a whole
+	// cannot be read.
+	Parse func(r io.Reader) ([]*Record, error)
+}
+
+var formats = make(map[string]*Format)
+
+// Register - make a format available by its name, replacing any format registered with the same name
+func Register(format *Format) {
+	formats[strings.ToLower(format.Name)] = format
+}
+
+// Get - the format registered with a name, ignoring case
+func Get(name string) (*Format, bool) {
+	format, ok := formats[strings.ToLower(name)]
+	return format, ok
+}
+
+// Formats - every registered format, ordered by name
+func Formats() []*Format {
+	toReturn := make([]*Format, 0, len(formats))
+	for _, format := range formats {
+		toReturn = append(toReturn, format)
+	}
+	sort.Slice(toReturn, func(i, j int) bool {
+		return toReturn[i].Name < toReturn[j].Name
+	})
+	return toReturn
+}
+
+// Parse - the records of an export in the named format
+func Parse(name string, r io.Reader) ([]*Record, error) {
+	format, ok := Get(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown import format %q", name)
+	}
+	return format.Parse(r)
+}
+
+// scaleScore - a value on a mood scale from low to high mapped onto the score range, rounded to the nearest score
+func scaleScore(value float64, low float64, high float64) int32 {
+	scaled := score.Min + (value-low)*(score.Max-score.Min)/(high-low)
+	return int32(math.Round(math.Max(score.Min, math.Min(score.Max, scaled))))
+}
+
+// invalid - a record for a row that could not be parsed. Rows are numbered from 0 in the order records are returned,
+// the same numbering as ImportRowError.Row.
+func invalid(row int, format string, args ...interface{}) *Record {
+	return &Record{Err: fmt.Errorf("row %v: %v", row, fmt.Sprintf(format, args...))}
+}
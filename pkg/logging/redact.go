@@ -0,0 +1,105 @@
+package logging
+
+import (
+	"net/url"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RedactedText - what sensitive values are replaced with in logs
+const RedactedText = "[REDACTED]"
+
+// sensitiveFields - proto fields holding journal text or other free text a user writes about themselves, which
+// must never reach the logs
+var sensitiveFields = map[protoreflect.FullName]bool{
+	"kic.health.MentalHealthLog.journalName": true,
+	"kic.health.MentalHealthLog.tags":        true,
+	"kic.health.SurveyAnswer.text":           true,
+}
+
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// Redact - a copy of v that is safe to log. Proto messages, and slices of them, have their sensitive fields masked
+// wherever they are nested. Anything else is returned as it is.
+func Redact(v interface{}) interface{} {
+	if message, ok := v.(proto.Message); ok {
+		return redactMessage(message)
+	}
+
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice || !value.Type().Elem().Implements(protoMessageType) {
+		return v
+	}
+
+	redacted := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+	for i := 0; i < value.Len(); i++ {
+		if message, ok := value.Index(i).Interface().(proto.Message); ok && !value.Index(i).IsNil() {
+			redacted.Index(i).Set(reflect.ValueOf(redactMessage(message)))
+		}
+	}
+	return redacted.Interface()
+}
+
+// RedactURI - uri with any password in it masked, for logging connection strings
+func RedactURI(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return RedactedText
+	}
+	if _, ok := parsed.User.Password(); ok {
+		parsed.User = url.UserPassword(parsed.User.Username(), RedactedText)
+	}
+	return parsed.String()
+}
+
+// redactMessage - a copy of message with its sensitive fields masked
+func redactMessage(message proto.Message) proto.Message {
+	redacted := proto.Clone(message)
+	if redacted != nil {
+		maskFields(redacted.ProtoReflect())
+	}
+	return redacted
+}
+
+// maskFields - mask the sensitive fields of message and every message nested in it
+func maskFields(message protoreflect.Message) {
+	masked := make(map[protoreflect.FieldDescriptor]protoreflect.Value)
+	maskedLists := make([]protoreflect.List, 0)
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case sensitiveFields[field.FullName()] && field.Kind() == protoreflect.StringKind && !field.IsList():
+			masked[field] = protoreflect.ValueOfString(RedactedText)
+		case sensitiveFields[field.FullName()] && field.Kind() == protoreflect.StringKind:
+			maskedLists = append(maskedLists, value.List())
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+					maskFields(entry.Message())
+					return true
+				})
+			}
+		case field.IsList():
+			if field.Message() != nil {
+				for i := 0; i < value.List().Len(); i++ {
+					maskFields(value.List().Get(i).Message())
+				}
+			}
+		case field.Message() != nil:
+			maskFields(value.Message())
+		}
+		return true
+	})
+
+	// set once iteration is done, as the message must not change under Range
+	for field, value := range masked {
+		message.Set(field, value)
+	}
+	for _, list := range maskedLists {
+		for i := 0; i < list.Len(); i++ {
+			list.Set(i, protoreflect.ValueOfString(RedactedText))
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package logtime
+
+import (
+	"fmt"
+	"time"
+	// the production image has no zoneinfo of its own
+	_ "time/tzdata"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kic/health/pkg/date"
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// DefaultTimeZone - the time zone of entries logged without one
+const DefaultTimeZone = "UTC"
+
+// LoadZone - the location of an IANA time zone, UTC when name is empty
+func LoadZone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimeZone
+	}
+	// Local is the server's zone, which says nothing about the user
+	if name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return location, nil
+}
+
+// Normalize - make the time fields of a log agree. A log with a loggedAt gets the date of that instant in its time
+// zone, a log with only a date gets the start of that day. Logs with neither are left without a time.
+func Normalize(healthLog *pbhealth.MentalHealthLog) error {
+	location, err := LoadZone(healthLog.TimeZone)
+	if err != nil {
+		return err
+	}
+	healthLog.TimeZone = location.String()
+
+	if healthLog.LoggedAt == nil || healthLog.DateOnly {
+		if healthLog.LogDate == nil {
+			healthLog.LoggedAt = nil
+			healthLog.DateOnly = false
+			return nil
+		}
+		healthLog.LoggedAt = timestamppb.New(date.ToTime(healthLog.LogDate, location))
+		healthLog.DateOnly = true
+		return nil
+	}
+
+	if err = healthLog.LoggedAt.CheckValid(); err != nil {
+		return fmt.Errorf("loggedAt is not a valid time")
+	}
+	localDate := date.FromTime(healthLog.LoggedAt.AsTime().In(location))
+	if healthLog.LogDate != nil && !date.Equal(healthLog.LogDate, localDate) {
+		return fmt.Errorf("logDate must be the date of loggedAt in %v, %v", location, date.Format(localDate))
+	}
+	healthLog.LogDate = localDate
+
+	return nil
+}
+
+// LocalTime - when a log was made, in its own time zone, and false for logs that only have a date
+func LocalTime(healthLog *pbhealth.MentalHealthLog) (time.Time, bool) {
+	if healthLog.LoggedAt == nil || healthLog.DateOnly {
+		return time.Time{}, false
+	}
+	location, err := LoadZone(healthLog.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	return healthLog.LoggedAt.AsTime().In(location), true
+}
+
+// PartOfDay - the part of the day a log was made in, in its own time zone, and false for logs that only have a date
+func PartOfDay(healthLog *pbhealth.MentalHealthLog) (pbhealth.TimeOfDay, bool) {
+	local, ok := LocalTime(healthLog)
+	if !ok {
+		return 0, false
+	}
+
+	switch hour := local.Hour(); {
+	case hour >= 5 && hour < 12:
+		return pbhealth.TimeOfDay_MORNING, true
+	case hour >= 12 && hour < 17:
+		return pbhealth.TimeOfDay_AFTERNOON, true
+	case hour >= 17 && hour < 22:
+		return pbhealth.TimeOfDay_EVENING, true
+	default:
+		return pbhealth.TimeOfDay_NIGHT, true
+	}
+}
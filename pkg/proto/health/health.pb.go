@@ -5,17 +5,18 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.13.0
 // source: proto/health.proto
 
 package proto
 
 import (
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	common "github.com/kic/health/pkg/proto/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -27,8 +28,404 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-//
-//Request from a user to get their mental health tracking data.
+// Order in which a list of mental health logs is returned, by log date.
+type SortOrder int32
+
+const (
+	SortOrder_ASCENDING  SortOrder = 0
+	SortOrder_DESCENDING SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "ASCENDING",
+		1: "DESCENDING",
+	}
+	SortOrder_value = map[string]int32{
+		"ASCENDING":  0,
+		"DESCENDING": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{0}
+}
+
+type TimeOfDay int32
+
+const (
+	//MORNING is from 5:00 until noon.
+	TimeOfDay_MORNING TimeOfDay = 0
+	//AFTERNOON is from noon until 17:00.
+	TimeOfDay_AFTERNOON TimeOfDay = 1
+	//EVENING is from 17:00 until 22:00.
+	TimeOfDay_EVENING TimeOfDay = 2
+	//NIGHT is from 22:00 until 5:00.
+	TimeOfDay_NIGHT TimeOfDay = 3
+)
+
+// Enum value maps for TimeOfDay.
+var (
+	TimeOfDay_name = map[int32]string{
+		0: "MORNING",
+		1: "AFTERNOON",
+		2: "EVENING",
+		3: "NIGHT",
+	}
+	TimeOfDay_value = map[string]int32{
+		"MORNING":   0,
+		"AFTERNOON": 1,
+		"EVENING":   2,
+		"NIGHT":     3,
+	}
+)
+
+func (x TimeOfDay) Enum() *TimeOfDay {
+	p := new(TimeOfDay)
+	*p = x
+	return p
+}
+
+func (x TimeOfDay) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeOfDay) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[1].Descriptor()
+}
+
+func (TimeOfDay) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[1]
+}
+
+func (x TimeOfDay) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeOfDay.Descriptor instead.
+func (TimeOfDay) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{1}
+}
+
+// GrantScope denotes how much of their health data a user shares through an access grant.
+type GrantScope int32
+
+const (
+	//SCORES_ONLY shares the user's mental health score and the scores of their logs, without journal entries or tags.
+	GrantScope_SCORES_ONLY GrantScope = 0
+	//SCORES_AND_JOURNALS shares the user's mental health score and their logs, journal entries included.
+	GrantScope_SCORES_AND_JOURNALS GrantScope = 1
+)
+
+// Enum value maps for GrantScope.
+var (
+	GrantScope_name = map[int32]string{
+		0: "SCORES_ONLY",
+		1: "SCORES_AND_JOURNALS",
+	}
+	GrantScope_value = map[string]int32{
+		"SCORES_ONLY":         0,
+		"SCORES_AND_JOURNALS": 1,
+	}
+)
+
+func (x GrantScope) Enum() *GrantScope {
+	p := new(GrantScope)
+	*p = x
+	return p
+}
+
+func (x GrantScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GrantScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[2].Descriptor()
+}
+
+func (GrantScope) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[2]
+}
+
+func (x GrantScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GrantScope.Descriptor instead.
+func (GrantScope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{2}
+}
+
+// ExportFormat denotes how exported records are encoded.
+type ExportFormat int32
+
+const (
+	//NDJSON encodes one JSON object per record, one record per line.
+	ExportFormat_NDJSON ExportFormat = 0
+	//CSV encodes one row per record, with a header row at the start of each record type.
+	ExportFormat_CSV ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "NDJSON",
+		1: "CSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"NDJSON": 0,
+		"CSV":    1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[3]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportRowErrorReason int32
+
+const (
+	ImportRowErrorReason_INVALID   ImportRowErrorReason = 0
+	ImportRowErrorReason_DUPLICATE ImportRowErrorReason = 1
+)
+
+// Enum value maps for ImportRowErrorReason.
+var (
+	ImportRowErrorReason_name = map[int32]string{
+		0: "INVALID",
+		1: "DUPLICATE",
+	}
+	ImportRowErrorReason_value = map[string]int32{
+		"INVALID":   0,
+		"DUPLICATE": 1,
+	}
+)
+
+func (x ImportRowErrorReason) Enum() *ImportRowErrorReason {
+	p := new(ImportRowErrorReason)
+	*p = x
+	return p
+}
+
+func (x ImportRowErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportRowErrorReason) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[4]
+}
+
+func (x ImportRowErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowErrorReason.Descriptor instead.
+func (ImportRowErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{4}
+}
+
+type AssessmentType int32
+
+const (
+	AssessmentType_PHQ9 AssessmentType = 0
+	AssessmentType_GAD7 AssessmentType = 1
+)
+
+// Enum value maps for AssessmentType.
+var (
+	AssessmentType_name = map[int32]string{
+		0: "PHQ9",
+		1: "GAD7",
+	}
+	AssessmentType_value = map[string]int32{
+		"PHQ9": 0,
+		"GAD7": 1,
+	}
+)
+
+func (x AssessmentType) Enum() *AssessmentType {
+	p := new(AssessmentType)
+	*p = x
+	return p
+}
+
+func (x AssessmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssessmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[5].Descriptor()
+}
+
+func (AssessmentType) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[5]
+}
+
+func (x AssessmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssessmentType.Descriptor instead.
+func (AssessmentType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{5}
+}
+
+type AssessmentSeverity int32
+
+const (
+	AssessmentSeverity_MINIMAL           AssessmentSeverity = 0
+	AssessmentSeverity_MILD              AssessmentSeverity = 1
+	AssessmentSeverity_MODERATE          AssessmentSeverity = 2
+	AssessmentSeverity_MODERATELY_SEVERE AssessmentSeverity = 3
+	AssessmentSeverity_SEVERE            AssessmentSeverity = 4
+)
+
+// Enum value maps for AssessmentSeverity.
+var (
+	AssessmentSeverity_name = map[int32]string{
+		0: "MINIMAL",
+		1: "MILD",
+		2: "MODERATE",
+		3: "MODERATELY_SEVERE",
+		4: "SEVERE",
+	}
+	AssessmentSeverity_value = map[string]int32{
+		"MINIMAL":           0,
+		"MILD":              1,
+		"MODERATE":          2,
+		"MODERATELY_SEVERE": 3,
+		"SEVERE":            4,
+	}
+)
+
+func (x AssessmentSeverity) Enum() *AssessmentSeverity {
+	p := new(AssessmentSeverity)
+	*p = x
+	return p
+}
+
+func (x AssessmentSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssessmentSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[6].Descriptor()
+}
+
+func (AssessmentSeverity) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[6]
+}
+
+func (x AssessmentSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssessmentSeverity.Descriptor instead.
+func (AssessmentSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{6}
+}
+
+type QuestionType int32
+
+const (
+	QuestionType_SCALE           QuestionType = 0
+	QuestionType_MULTIPLE_CHOICE QuestionType = 1
+	QuestionType_FREE_TEXT       QuestionType = 2
+)
+
+// Enum value maps for QuestionType.
+var (
+	QuestionType_name = map[int32]string{
+		0: "SCALE",
+		1: "MULTIPLE_CHOICE",
+		2: "FREE_TEXT",
+	}
+	QuestionType_value = map[string]int32{
+		"SCALE":           0,
+		"MULTIPLE_CHOICE": 1,
+		"FREE_TEXT":       2,
+	}
+)
+
+func (x QuestionType) Enum() *QuestionType {
+	p := new(QuestionType)
+	*p = x
+	return p
+}
+
+func (x QuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_health_proto_enumTypes[7].Descriptor()
+}
+
+func (QuestionType) Type() protoreflect.EnumType {
+	return &file_proto_health_proto_enumTypes[7]
+}
+
+func (x QuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionType.Descriptor instead.
+func (QuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{7}
+}
+
+// Request from a user to get their mental health tracking data.
 type GetHealthDataForUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -77,8 +474,7 @@ func (x *GetHealthDataForUserRequest) GetUserID() int64 {
 	return 0
 }
 
-//
-//Response to a user with complete mental health log
+// Response to a user with complete mental health log
 type MentalHealthLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -92,6 +488,24 @@ type MentalHealthLog struct {
 	JournalName string `protobuf:"bytes,3,opt,name=journalName,proto3" json:"journalName,omitempty"`
 	// The ID of the user in the user database, used globally for identification.
 	UserID int64 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the unique ID of the log entry, assigned when it is added.
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	//version denotes how many times the log entry has been written, starting at 1 when it is added.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	//deletedAt denotes when the log entry was moved to the trash. It is unset for entries that are not deleted.
+	DeletedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	//dimensionScores denotes ratings of named mood dimensions, such as energy or stress, besides the overall score.
+	DimensionScores []*DimensionScore `protobuf:"bytes,8,rep,name=dimensionScores,proto3" json:"dimensionScores,omitempty"`
+	//tags denotes the activities and emotions of the day, such as "work", "exercise" or "grateful".
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	//loggedAt denotes when the entry was logged. When it is set, logDate is derived from it in timeZone. When it is
+	//not, it is filled in with the start of logDate and dateOnly is set. Updates change logDate, loggedAt, timeZone
+	//and dateOnly together.
+	LoggedAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=loggedAt,proto3" json:"loggedAt,omitempty"`
+	//timeZone denotes the IANA time zone the entry was logged in, such as "Europe/Berlin". UTC is used when it is empty.
+	TimeZone string `protobuf:"bytes,11,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	//dateOnly denotes that only the date of the entry is known, and loggedAt holds the start of that day.
+	DateOnly bool `protobuf:"varint,12,opt,name=dateOnly,proto3" json:"dateOnly,omitempty"`
 }
 
 func (x *MentalHealthLog) Reset() {
@@ -154,19 +568,75 @@ func (x *MentalHealthLog) GetUserID() int64 {
 	return 0
 }
 
-//
-//Response to a user when user asks for health data.
-type GetHealthDataForUserResponse struct {
+func (x *MentalHealthLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MentalHealthLog) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MentalHealthLog) GetDeletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *MentalHealthLog) GetDimensionScores() []*DimensionScore {
+	if x != nil {
+		return x.DimensionScores
+	}
+	return nil
+}
+
+func (x *MentalHealthLog) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MentalHealthLog) GetLoggedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LoggedAt
+	}
+	return nil
+}
+
+func (x *MentalHealthLog) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *MentalHealthLog) GetDateOnly() bool {
+	if x != nil {
+		return x.DateOnly
+	}
+	return false
+}
+
+type DimensionScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//healthData denotes the data that was requested by user from mental health log
-	HealthData []*MentalHealthLog `protobuf:"bytes,1,rep,name=healthData,proto3" json:"healthData,omitempty"`
+	//dimension denotes the name of the mood dimension rated.
+	Dimension string `protobuf:"bytes,1,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	//score denotes the rating, within the range of the dimension.
+	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *GetHealthDataForUserResponse) Reset() {
-	*x = GetHealthDataForUserResponse{}
+func (x *DimensionScore) Reset() {
+	*x = DimensionScore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_health_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,13 +644,13 @@ func (x *GetHealthDataForUserResponse) Reset() {
 	}
 }
 
-func (x *GetHealthDataForUserResponse) String() string {
+func (x *DimensionScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHealthDataForUserResponse) ProtoMessage() {}
+func (*DimensionScore) ProtoMessage() {}
 
-func (x *GetHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
+func (x *DimensionScore) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_health_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,31 +662,37 @@ func (x *GetHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHealthDataForUserResponse.ProtoReflect.Descriptor instead.
-func (*GetHealthDataForUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DimensionScore.ProtoReflect.Descriptor instead.
+func (*DimensionScore) Descriptor() ([]byte, []int) {
 	return file_proto_health_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetHealthDataForUserResponse) GetHealthData() []*MentalHealthLog {
+func (x *DimensionScore) GetDimension() string {
 	if x != nil {
-		return x.HealthData
+		return x.Dimension
 	}
-	return nil
+	return ""
 }
 
-type GetHealthDataByDateRequest struct {
+func (x *DimensionScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Response to a user when user asks for health data.
+type GetHealthDataForUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The ID of the user in the user database, used globally for identification.
-	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	//Date of Mental Health Log Entry
-	LogDate *common.Date `protobuf:"bytes,2,opt,name=logDate,proto3" json:"logDate,omitempty"`
+	//healthData denotes the data that was requested by user from mental health log
+	HealthData []*MentalHealthLog `protobuf:"bytes,1,rep,name=healthData,proto3" json:"healthData,omitempty"`
 }
 
-func (x *GetHealthDataByDateRequest) Reset() {
-	*x = GetHealthDataByDateRequest{}
+func (x *GetHealthDataForUserResponse) Reset() {
+	*x = GetHealthDataForUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_health_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -224,13 +700,13 @@ func (x *GetHealthDataByDateRequest) Reset() {
 	}
 }
 
-func (x *GetHealthDataByDateRequest) String() string {
+func (x *GetHealthDataForUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHealthDataByDateRequest) ProtoMessage() {}
+func (*GetHealthDataForUserResponse) ProtoMessage() {}
 
-func (x *GetHealthDataByDateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_health_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,9 +718,59 @@ func (x *GetHealthDataByDateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use GetHealthDataForUserResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthDataForUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetHealthDataForUserResponse) GetHealthData() []*MentalHealthLog {
+	if x != nil {
+		return x.HealthData
+	}
+	return nil
+}
+
+type GetHealthDataByDateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//Date of Mental Health Log Entry
+	LogDate *common.Date `protobuf:"bytes,2,opt,name=logDate,proto3" json:"logDate,omitempty"`
+}
+
+func (x *GetHealthDataByDateRequest) Reset() {
+	*x = GetHealthDataByDateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthDataByDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthDataByDateRequest) ProtoMessage() {}
+
+func (x *GetHealthDataByDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use GetHealthDataByDateRequest.ProtoReflect.Descriptor instead.
 func (*GetHealthDataByDateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{3}
+	return file_proto_health_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetHealthDataByDateRequest) GetUserID() int64 {
@@ -273,7 +799,7 @@ type GetHealthDataByDateResponse struct {
 func (x *GetHealthDataByDateResponse) Reset() {
 	*x = GetHealthDataByDateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[4]
+		mi := &file_proto_health_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -286,7 +812,7 @@ func (x *GetHealthDataByDateResponse) String() string {
 func (*GetHealthDataByDateResponse) ProtoMessage() {}
 
 func (x *GetHealthDataByDateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[4]
+	mi := &file_proto_health_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +825,7 @@ func (x *GetHealthDataByDateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHealthDataByDateResponse.ProtoReflect.Descriptor instead.
 func (*GetHealthDataByDateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{4}
+	return file_proto_health_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetHealthDataByDateResponse) GetHealthData() []*MentalHealthLog {
@@ -309,8 +835,7 @@ func (x *GetHealthDataByDateResponse) GetHealthData() []*MentalHealthLog {
 	return nil
 }
 
-//
-//Request from a user to add their mental health data to MentalHealthLog.
+// Request from a user to add their mental health data to MentalHealthLog.
 type AddHealthDataForUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -320,12 +845,15 @@ type AddHealthDataForUserRequest struct {
 	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	//newEntry denotes the ID of the new entry that is requested to be made.
 	NewEntry *MentalHealthLog `protobuf:"bytes,2,opt,name=newEntry,proto3" json:"newEntry,omitempty"`
+	//idempotencyKey denotes a client chosen key that makes retries of this request return the first response
+	//instead of adding the entry again. It can also be sent as idempotency-key metadata.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *AddHealthDataForUserRequest) Reset() {
 	*x = AddHealthDataForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[5]
+		mi := &file_proto_health_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +866,7 @@ func (x *AddHealthDataForUserRequest) String() string {
 func (*AddHealthDataForUserRequest) ProtoMessage() {}
 
 func (x *AddHealthDataForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[5]
+	mi := &file_proto_health_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +879,7 @@ func (x *AddHealthDataForUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddHealthDataForUserRequest.ProtoReflect.Descriptor instead.
 func (*AddHealthDataForUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{5}
+	return file_proto_health_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AddHealthDataForUserRequest) GetUserID() int64 {
@@ -368,18 +896,27 @@ func (x *AddHealthDataForUserRequest) GetNewEntry() *MentalHealthLog {
 	return nil
 }
 
+func (x *AddHealthDataForUserRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type AddHealthDataForUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	//id denotes the ID assigned to the new entry.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *AddHealthDataForUserResponse) Reset() {
 	*x = AddHealthDataForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[6]
+		mi := &file_proto_health_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +929,7 @@ func (x *AddHealthDataForUserResponse) String() string {
 func (*AddHealthDataForUserResponse) ProtoMessage() {}
 
 func (x *AddHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[6]
+	mi := &file_proto_health_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +942,7 @@ func (x *AddHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddHealthDataForUserResponse.ProtoReflect.Descriptor instead.
 func (*AddHealthDataForUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{6}
+	return file_proto_health_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AddHealthDataForUserResponse) GetSuccess() bool {
@@ -415,8 +952,14 @@ func (x *AddHealthDataForUserResponse) GetSuccess() bool {
 	return false
 }
 
-//
-//Request from a user to delete their mental health data from MentalHealthLog.
+func (x *AddHealthDataForUserResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request from a user to delete their mental health data from MentalHealthLog.
 type DeleteHealthDataForUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -435,7 +978,7 @@ type DeleteHealthDataForUserRequest struct {
 func (x *DeleteHealthDataForUserRequest) Reset() {
 	*x = DeleteHealthDataForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[7]
+		mi := &file_proto_health_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +991,7 @@ func (x *DeleteHealthDataForUserRequest) String() string {
 func (*DeleteHealthDataForUserRequest) ProtoMessage() {}
 
 func (x *DeleteHealthDataForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[7]
+	mi := &file_proto_health_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +1004,7 @@ func (x *DeleteHealthDataForUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHealthDataForUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteHealthDataForUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{7}
+	return file_proto_health_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteHealthDataForUserRequest) GetUserID() int64 {
@@ -510,8 +1053,7 @@ func (*DeleteHealthDataForUserRequest_All) isDeleteHealthDataForUserRequest_Data
 
 func (*DeleteHealthDataForUserRequest_DateToRemove) isDeleteHealthDataForUserRequest_Data() {}
 
-//
-//Response to a user when user asks to delete health data.
+// Response to a user when user asks to delete health data.
 type DeleteHealthDataForUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -524,7 +1066,7 @@ type DeleteHealthDataForUserResponse struct {
 func (x *DeleteHealthDataForUserResponse) Reset() {
 	*x = DeleteHealthDataForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[8]
+		mi := &file_proto_health_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +1079,7 @@ func (x *DeleteHealthDataForUserResponse) String() string {
 func (*DeleteHealthDataForUserResponse) ProtoMessage() {}
 
 func (x *DeleteHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[8]
+	mi := &file_proto_health_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +1092,7 @@ func (x *DeleteHealthDataForUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHealthDataForUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteHealthDataForUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{8}
+	return file_proto_health_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteHealthDataForUserResponse) GetEntriesDeleted() uint32 {
@@ -560,8 +1102,7 @@ func (x *DeleteHealthDataForUserResponse) GetEntriesDeleted() uint32 {
 	return 0
 }
 
-//
-//Request from a user to update their mental health tracking data for a particular date.
+// Request from a user to update their mental health tracking data for a particular date.
 type UpdateHealthDataForDateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -571,12 +1112,18 @@ type UpdateHealthDataForDateRequest struct {
 	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	//The desiredLogInfo denotes the log info that the user would like to update.
 	DesiredLogInfo *MentalHealthLog `protobuf:"bytes,2,opt,name=desiredLogInfo,proto3" json:"desiredLogInfo,omitempty"`
+	//updateMask denotes the fields of desiredLogInfo to apply, out of score and journalName. All of them are
+	//applied when it is empty.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	//expectedVersion denotes the version every log entry for the date must still have for the update to go
+	//through. The update is unconditional when it is 0.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
 func (x *UpdateHealthDataForDateRequest) Reset() {
 	*x = UpdateHealthDataForDateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[9]
+		mi := &file_proto_health_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1136,7 @@ func (x *UpdateHealthDataForDateRequest) String() string {
 func (*UpdateHealthDataForDateRequest) ProtoMessage() {}
 
 func (x *UpdateHealthDataForDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[9]
+	mi := &file_proto_health_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1149,7 @@ func (x *UpdateHealthDataForDateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHealthDataForDateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHealthDataForDateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{9}
+	return file_proto_health_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateHealthDataForDateRequest) GetUserID() int64 {
@@ -619,6 +1166,20 @@ func (x *UpdateHealthDataForDateRequest) GetDesiredLogInfo() *MentalHealthLog {
 	return nil
 }
 
+func (x *UpdateHealthDataForDateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateHealthDataForDateRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateHealthDataForDateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -630,7 +1191,7 @@ type UpdateHealthDataForDateResponse struct {
 func (x *UpdateHealthDataForDateResponse) Reset() {
 	*x = UpdateHealthDataForDateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[10]
+		mi := &file_proto_health_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -643,7 +1204,7 @@ func (x *UpdateHealthDataForDateResponse) String() string {
 func (*UpdateHealthDataForDateResponse) ProtoMessage() {}
 
 func (x *UpdateHealthDataForDateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[10]
+	mi := &file_proto_health_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +1217,7 @@ func (x *UpdateHealthDataForDateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHealthDataForDateResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHealthDataForDateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{10}
+	return file_proto_health_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateHealthDataForDateResponse) GetSuccess() bool {
@@ -666,8 +1227,7 @@ func (x *UpdateHealthDataForDateResponse) GetSuccess() bool {
 	return false
 }
 
-//
-//Request form a user to get a mental health score
+// Request form a user to get a mental health score
 type GetMentalHealthScoreForUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -675,12 +1235,14 @@ type GetMentalHealthScoreForUserRequest struct {
 
 	// The ID of the user in the user database, used globally for identification.
 	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//dimension denotes the mood dimension to score. The overall score is returned if it is empty.
+	Dimension string `protobuf:"bytes,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
 }
 
 func (x *GetMentalHealthScoreForUserRequest) Reset() {
 	*x = GetMentalHealthScoreForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[11]
+		mi := &file_proto_health_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +1255,7 @@ func (x *GetMentalHealthScoreForUserRequest) String() string {
 func (*GetMentalHealthScoreForUserRequest) ProtoMessage() {}
 
 func (x *GetMentalHealthScoreForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[11]
+	mi := &file_proto_health_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +1268,7 @@ func (x *GetMentalHealthScoreForUserRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetMentalHealthScoreForUserRequest.ProtoReflect.Descriptor instead.
 func (*GetMentalHealthScoreForUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{11}
+	return file_proto_health_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetMentalHealthScoreForUserRequest) GetUserID() int64 {
@@ -716,8 +1278,14 @@ func (x *GetMentalHealthScoreForUserRequest) GetUserID() int64 {
 	return 0
 }
 
-//
-//Response to return a mental health score given a user ID
+func (x *GetMentalHealthScoreForUserRequest) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+// Response to return a mental health score given a user ID
 type GetMentalHealthScoreForUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -725,12 +1293,14 @@ type GetMentalHealthScoreForUserResponse struct {
 
 	// overall mental health score for user
 	Score int32 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	//rated denotes whether the user has rated the dimension asked for, as 0 is a valid score of some dimensions. It is only set when a dimension is asked for.
+	Rated bool `protobuf:"varint,2,opt,name=rated,proto3" json:"rated,omitempty"`
 }
 
 func (x *GetMentalHealthScoreForUserResponse) Reset() {
 	*x = GetMentalHealthScoreForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_health_proto_msgTypes[12]
+		mi := &file_proto_health_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -743,7 +1313,7 @@ func (x *GetMentalHealthScoreForUserResponse) String() string {
 func (*GetMentalHealthScoreForUserResponse) ProtoMessage() {}
 
 func (x *GetMentalHealthScoreForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_health_proto_msgTypes[12]
+	mi := &file_proto_health_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1326,7 @@ func (x *GetMentalHealthScoreForUserResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetMentalHealthScoreForUserResponse.ProtoReflect.Descriptor instead.
 func (*GetMentalHealthScoreForUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_health_proto_rawDescGZIP(), []int{12}
+	return file_proto_health_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetMentalHealthScoreForUserResponse) GetScore() int32 {
@@ -766,248 +1336,7806 @@ func (x *GetMentalHealthScoreForUserResponse) GetScore() int32 {
 	return 0
 }
 
-var File_proto_health_proto protoreflect.FileDescriptor
+func (x *GetMentalHealthScoreForUserResponse) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
 
-var file_proto_health_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x8d, 0x01, 0x0a,
-	0x0f, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67,
-	0x12, 0x2a, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44,
-	0x61, 0x74, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5b, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x2a, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61,
-	0x74, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x37,
-	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x6e,
-	0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x38, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x8c, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c,
-	0x12, 0x36, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x49, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x1e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
-	0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
-	0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61,
-	0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x69,
-	0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3b, 0x0a, 0x1f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f,
-	0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46,
-	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x32, 0xb6, 0x05, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e,
-	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
-	0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x69, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
-	0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
-	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41,
-	0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
-	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61,
-	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x72, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
-	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6b, 0x69, 0x63,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x2e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16, 0x5a, 0x14, 0x2e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Request from a user to get their mental health tracking data between two dates, one page at a time.
+type GetHealthDataInRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//startDate denotes the first date of the range, inclusive.
+	StartDate *common.Date `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	//endDate denotes the last date of the range, inclusive.
+	EndDate *common.Date `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	//sortOrder denotes whether the oldest or the newest logs are returned first.
+	SortOrder SortOrder `protobuf:"varint,4,opt,name=sortOrder,proto3,enum=kic.health.SortOrder" json:"sortOrder,omitempty"`
+	//pageSize denotes the maximum number of logs to return. A default is used when it is not set.
+	PageSize int32 `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	//pageToken is the nextPageToken of a previous response, used to fetch the following page.
+	PageToken string `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 }
 
-var (
-	file_proto_health_proto_rawDescOnce sync.Once
-	file_proto_health_proto_rawDescData = file_proto_health_proto_rawDesc
-)
+func (x *GetHealthDataInRangeRequest) Reset() {
+	*x = GetHealthDataInRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_health_proto_rawDescGZIP() []byte {
-	file_proto_health_proto_rawDescOnce.Do(func() {
-		file_proto_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_health_proto_rawDescData)
-	})
-	return file_proto_health_proto_rawDescData
+func (x *GetHealthDataInRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_health_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_proto_health_proto_goTypes = []interface{}{
-	(*GetHealthDataForUserRequest)(nil),         // 0: kic.health.GetHealthDataForUserRequest
-	(*MentalHealthLog)(nil),                     // 1: kic.health.MentalHealthLog
-	(*GetHealthDataForUserResponse)(nil),        // 2: kic.health.GetHealthDataForUserResponse
-	(*GetHealthDataByDateRequest)(nil),          // 3: kic.health.GetHealthDataByDateRequest
-	(*GetHealthDataByDateResponse)(nil),         // 4: kic.health.GetHealthDataByDateResponse
-	(*AddHealthDataForUserRequest)(nil),         // 5: kic.health.AddHealthDataForUserRequest
-	(*AddHealthDataForUserResponse)(nil),        // 6: kic.health.AddHealthDataForUserResponse
-	(*DeleteHealthDataForUserRequest)(nil),      // 7: kic.health.DeleteHealthDataForUserRequest
-	(*DeleteHealthDataForUserResponse)(nil),     // 8: kic.health.DeleteHealthDataForUserResponse
-	(*UpdateHealthDataForDateRequest)(nil),      // 9: kic.health.UpdateHealthDataForDateRequest
-	(*UpdateHealthDataForDateResponse)(nil),     // 10: kic.health.UpdateHealthDataForDateResponse
-	(*GetMentalHealthScoreForUserRequest)(nil),  // 11: kic.health.GetMentalHealthScoreForUserRequest
-	(*GetMentalHealthScoreForUserResponse)(nil), // 12: kic.health.GetMentalHealthScoreForUserResponse
-	(*common.Date)(nil),                         // 13: kic.common.Date
+func (*GetHealthDataInRangeRequest) ProtoMessage() {}
+
+func (x *GetHealthDataInRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_health_proto_depIdxs = []int32{
-	13, // 0: kic.health.MentalHealthLog.logDate:type_name -> kic.common.Date
-	1,  // 1: kic.health.GetHealthDataForUserResponse.healthData:type_name -> kic.health.MentalHealthLog
-	13, // 2: kic.health.GetHealthDataByDateRequest.logDate:type_name -> kic.common.Date
-	1,  // 3: kic.health.GetHealthDataByDateResponse.healthData:type_name -> kic.health.MentalHealthLog
-	1,  // 4: kic.health.AddHealthDataForUserRequest.newEntry:type_name -> kic.health.MentalHealthLog
-	13, // 5: kic.health.DeleteHealthDataForUserRequest.dateToRemove:type_name -> kic.common.Date
-	1,  // 6: kic.health.UpdateHealthDataForDateRequest.desiredLogInfo:type_name -> kic.health.MentalHealthLog
-	0,  // 7: kic.health.HealthTracking.GetHealthDataForUser:input_type -> kic.health.GetHealthDataForUserRequest
-	5,  // 8: kic.health.HealthTracking.AddHealthDataForUser:input_type -> kic.health.AddHealthDataForUserRequest
-	7,  // 9: kic.health.HealthTracking.DeleteHealthDataForUser:input_type -> kic.health.DeleteHealthDataForUserRequest
-	9,  // 10: kic.health.HealthTracking.UpdateHealthDataForDate:input_type -> kic.health.UpdateHealthDataForDateRequest
-	11, // 11: kic.health.HealthTracking.GetMentalHealthScoreForUser:input_type -> kic.health.GetMentalHealthScoreForUserRequest
-	3,  // 12: kic.health.HealthTracking.GetHealthDataByDate:input_type -> kic.health.GetHealthDataByDateRequest
-	2,  // 13: kic.health.HealthTracking.GetHealthDataForUser:output_type -> kic.health.GetHealthDataForUserResponse
-	6,  // 14: kic.health.HealthTracking.AddHealthDataForUser:output_type -> kic.health.AddHealthDataForUserResponse
-	8,  // 15: kic.health.HealthTracking.DeleteHealthDataForUser:output_type -> kic.health.DeleteHealthDataForUserResponse
-	10, // 16: kic.health.HealthTracking.UpdateHealthDataForDate:output_type -> kic.health.UpdateHealthDataForDateResponse
-	12, // 17: kic.health.HealthTracking.GetMentalHealthScoreForUser:output_type -> kic.health.GetMentalHealthScoreForUserResponse
-	4,  // 18: kic.health.HealthTracking.GetHealthDataByDate:output_type -> kic.health.GetHealthDataByDateResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+// Deprecated: Use GetHealthDataInRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthDataInRangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{14}
 }
 
-func init() { file_proto_health_proto_init() }
-func file_proto_health_proto_init() {
-	if File_proto_health_proto != nil {
-		return
+func (x *GetHealthDataInRangeRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_health_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthDataForUserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_health_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MentalHealthLog); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_health_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthDataForUserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_health_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthDataByDateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+	return 0
+}
+
+func (x *GetHealthDataInRangeRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetHealthDataInRangeRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetHealthDataInRangeRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_ASCENDING
+}
+
+func (x *GetHealthDataInRangeRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHealthDataInRangeRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response to a user with one page of mental health logs within a date range.
+type GetHealthDataInRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//healthData denotes the data that was requested by user from mental health log
+	HealthData []*MentalHealthLog `protobuf:"bytes,1,rep,name=healthData,proto3" json:"healthData,omitempty"`
+	//nextPageToken is passed as the pageToken of the next request, and is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *GetHealthDataInRangeResponse) Reset() {
+	*x = GetHealthDataInRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthDataInRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthDataInRangeResponse) ProtoMessage() {}
+
+func (x *GetHealthDataInRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthDataInRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthDataInRangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetHealthDataInRangeResponse) GetHealthData() []*MentalHealthLog {
+	if x != nil {
+		return x.HealthData
+	}
+	return nil
+}
+
+func (x *GetHealthDataInRangeResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request from a user to get statistics on how their mental health scores changed over a date range.
+type GetMoodTrendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//startDate denotes the first date of the range, inclusive.
+	StartDate *common.Date `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	//endDate denotes the last date of the range, inclusive.
+	EndDate *common.Date `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	//dimension denotes the mood dimension to follow. The overall score is followed if it is empty.
+	Dimension string `protobuf:"bytes,4,opt,name=dimension,proto3" json:"dimension,omitempty"`
+}
+
+func (x *GetMoodTrendsRequest) Reset() {
+	*x = GetMoodTrendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMoodTrendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoodTrendsRequest) ProtoMessage() {}
+
+func (x *GetMoodTrendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoodTrendsRequest.ProtoReflect.Descriptor instead.
+func (*GetMoodTrendsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMoodTrendsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetMoodTrendsRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsRequest) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+// Statistics on the mental health scores logged within one day, week or month.
+type MoodBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//startDate denotes the first day of the bucket. Weeks start on Monday and months on the first.
+	StartDate *common.Date `protobuf:"bytes,1,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	//endDate denotes the last day of the bucket.
+	EndDate *common.Date `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	//mean denotes the average score of the logs in the bucket.
+	Mean float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	//min denotes the lowest score logged in the bucket.
+	Min int32 `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	//max denotes the highest score logged in the bucket.
+	Max int32 `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	//count denotes the number of logs in the bucket.
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MoodBucket) Reset() {
+	*x = MoodBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoodBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoodBucket) ProtoMessage() {}
+
+func (x *MoodBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoodBucket.ProtoReflect.Descriptor instead.
+func (*MoodBucket) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MoodBucket) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *MoodBucket) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *MoodBucket) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *MoodBucket) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MoodBucket) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MoodBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Rolling averages of the mental health scores logged on and before a day.
+type RollingAverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//Date the averages end on.
+	Date *common.Date `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	//sevenDayMean denotes the average score of the logs from the 7 days ending on date.
+	SevenDayMean float64 `protobuf:"fixed64,2,opt,name=sevenDayMean,proto3" json:"sevenDayMean,omitempty"`
+	//sevenDayCount denotes the number of logs from the 7 days ending on date.
+	SevenDayCount int32 `protobuf:"varint,3,opt,name=sevenDayCount,proto3" json:"sevenDayCount,omitempty"`
+	//thirtyDayMean denotes the average score of the logs from the 30 days ending on date.
+	ThirtyDayMean float64 `protobuf:"fixed64,4,opt,name=thirtyDayMean,proto3" json:"thirtyDayMean,omitempty"`
+	//thirtyDayCount denotes the number of logs from the 30 days ending on date.
+	ThirtyDayCount int32 `protobuf:"varint,5,opt,name=thirtyDayCount,proto3" json:"thirtyDayCount,omitempty"`
+}
+
+func (x *RollingAverage) Reset() {
+	*x = RollingAverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollingAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollingAverage) ProtoMessage() {}
+
+func (x *RollingAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollingAverage.ProtoReflect.Descriptor instead.
+func (*RollingAverage) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RollingAverage) GetDate() *common.Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *RollingAverage) GetSevenDayMean() float64 {
+	if x != nil {
+		return x.SevenDayMean
+	}
+	return 0
+}
+
+func (x *RollingAverage) GetSevenDayCount() int32 {
+	if x != nil {
+		return x.SevenDayCount
+	}
+	return 0
+}
+
+func (x *RollingAverage) GetThirtyDayMean() float64 {
+	if x != nil {
+		return x.ThirtyDayMean
+	}
+	return 0
+}
+
+func (x *RollingAverage) GetThirtyDayCount() int32 {
+	if x != nil {
+		return x.ThirtyDayCount
+	}
+	return 0
+}
+
+// Response to a user with statistics on their mental health scores. Buckets without logs are left out.
+type GetMoodTrendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//daily denotes one bucket per day with logs, oldest first.
+	Daily []*MoodBucket `protobuf:"bytes,1,rep,name=daily,proto3" json:"daily,omitempty"`
+	//weekly denotes one bucket per week with logs, oldest first.
+	Weekly []*MoodBucket `protobuf:"bytes,2,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	//monthly denotes one bucket per month with logs, oldest first.
+	Monthly []*MoodBucket `protobuf:"bytes,3,rep,name=monthly,proto3" json:"monthly,omitempty"`
+	//rollingAverages denotes the rolling averages for every day in the range, oldest first.
+	RollingAverages []*RollingAverage `protobuf:"bytes,4,rep,name=rollingAverages,proto3" json:"rollingAverages,omitempty"`
+	//timesOfDay denotes one bucket per part of the day with logs, in the time zone each log was made in. Logs that
+	//only have a date are left out.
+	TimesOfDay []*TimeOfDayBucket `protobuf:"bytes,5,rep,name=timesOfDay,proto3" json:"timesOfDay,omitempty"`
+}
+
+func (x *GetMoodTrendsResponse) Reset() {
+	*x = GetMoodTrendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMoodTrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoodTrendsResponse) ProtoMessage() {}
+
+func (x *GetMoodTrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoodTrendsResponse.ProtoReflect.Descriptor instead.
+func (*GetMoodTrendsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMoodTrendsResponse) GetDaily() []*MoodBucket {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsResponse) GetWeekly() []*MoodBucket {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsResponse) GetMonthly() []*MoodBucket {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsResponse) GetRollingAverages() []*RollingAverage {
+	if x != nil {
+		return x.RollingAverages
+	}
+	return nil
+}
+
+func (x *GetMoodTrendsResponse) GetTimesOfDay() []*TimeOfDayBucket {
+	if x != nil {
+		return x.TimesOfDay
+	}
+	return nil
+}
+
+// Statistics on the mental health scores logged within one part of the day, across the whole range.
+type TimeOfDayBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeOfDay TimeOfDay `protobuf:"varint,1,opt,name=timeOfDay,proto3,enum=kic.health.TimeOfDay" json:"timeOfDay,omitempty"`
+	//mean denotes the average score of the logs in the bucket.
+	Mean float64 `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	//count denotes the number of logs in the bucket.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TimeOfDayBucket) Reset() {
+	*x = TimeOfDayBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeOfDayBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOfDayBucket) ProtoMessage() {}
+
+func (x *TimeOfDayBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOfDayBucket.ProtoReflect.Descriptor instead.
+func (*TimeOfDayBucket) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TimeOfDayBucket) GetTimeOfDay() TimeOfDay {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return TimeOfDay_MORNING
+}
+
+func (x *TimeOfDayBucket) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *TimeOfDayBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request from a user to get a single mental health log entry.
+type GetHealthLogByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the ID of the log entry to get.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetHealthLogByIDRequest) Reset() {
+	*x = GetHealthLogByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthLogByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthLogByIDRequest) ProtoMessage() {}
+
+func (x *GetHealthLogByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthLogByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthLogByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetHealthLogByIDRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetHealthLogByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHealthLogByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//healthLog denotes the log entry with the requested ID.
+	HealthLog *MentalHealthLog `protobuf:"bytes,1,opt,name=healthLog,proto3" json:"healthLog,omitempty"`
+}
+
+func (x *GetHealthLogByIDResponse) Reset() {
+	*x = GetHealthLogByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthLogByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthLogByIDResponse) ProtoMessage() {}
+
+func (x *GetHealthLogByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthLogByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthLogByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetHealthLogByIDResponse) GetHealthLog() *MentalHealthLog {
+	if x != nil {
+		return x.HealthLog
+	}
+	return nil
+}
+
+// Request from a user to update a single mental health log entry.
+type UpdateHealthLogByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the ID of the log entry to update.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//The desiredLogInfo denotes the log info that the user would like to update.
+	DesiredLogInfo *MentalHealthLog `protobuf:"bytes,3,opt,name=desiredLogInfo,proto3" json:"desiredLogInfo,omitempty"`
+	//updateMask denotes the fields of desiredLogInfo to apply, out of logDate, score and journalName. All of
+	//them are applied when it is empty.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	//expectedVersion denotes the version the log entry must still have for the update to go through. The
+	//update is unconditional when it is 0.
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *UpdateHealthLogByIDRequest) Reset() {
+	*x = UpdateHealthLogByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHealthLogByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHealthLogByIDRequest) ProtoMessage() {}
+
+func (x *UpdateHealthLogByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHealthLogByIDRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHealthLogByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateHealthLogByIDRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateHealthLogByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateHealthLogByIDRequest) GetDesiredLogInfo() *MentalHealthLog {
+	if x != nil {
+		return x.DesiredLogInfo
+	}
+	return nil
+}
+
+func (x *UpdateHealthLogByIDRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateHealthLogByIDRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateHealthLogByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	//version denotes the version of the log entry after the update.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateHealthLogByIDResponse) Reset() {
+	*x = UpdateHealthLogByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHealthLogByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHealthLogByIDResponse) ProtoMessage() {}
+
+func (x *UpdateHealthLogByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHealthLogByIDResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHealthLogByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateHealthLogByIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateHealthLogByIDResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Request from a user to delete a single mental health log entry.
+type DeleteHealthLogByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the ID of the log entry to delete.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//expectedVersion denotes the version the log entry must still have for the delete to go through. The
+	//delete is unconditional when it is 0.
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *DeleteHealthLogByIDRequest) Reset() {
+	*x = DeleteHealthLogByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHealthLogByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHealthLogByIDRequest) ProtoMessage() {}
+
+func (x *DeleteHealthLogByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHealthLogByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHealthLogByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteHealthLogByIDRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteHealthLogByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteHealthLogByIDRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteHealthLogByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteHealthLogByIDResponse) Reset() {
+	*x = DeleteHealthLogByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHealthLogByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHealthLogByIDResponse) ProtoMessage() {}
+
+func (x *DeleteHealthLogByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHealthLogByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHealthLogByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteHealthLogByIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request from a user to list the mental health log entries in their trash.
+type ListDeletedHealthLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListDeletedHealthLogsRequest) Reset() {
+	*x = ListDeletedHealthLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedHealthLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedHealthLogsRequest) ProtoMessage() {}
+
+func (x *ListDeletedHealthLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedHealthLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedHealthLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDeletedHealthLogsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ListDeletedHealthLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//healthData denotes the deleted log entries that have not been purged yet, most recently deleted first.
+	HealthData []*MentalHealthLog `protobuf:"bytes,1,rep,name=healthData,proto3" json:"healthData,omitempty"`
+}
+
+func (x *ListDeletedHealthLogsResponse) Reset() {
+	*x = ListDeletedHealthLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedHealthLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedHealthLogsResponse) ProtoMessage() {}
+
+func (x *ListDeletedHealthLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedHealthLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedHealthLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListDeletedHealthLogsResponse) GetHealthData() []*MentalHealthLog {
+	if x != nil {
+		return x.HealthData
+	}
+	return nil
+}
+
+// Request from a user to move mental health log entries out of their trash.
+type RestoreHealthLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//Either restore every entry in the trash or specific entries
+	//
+	// Types that are assignable to Data:
+	//	*RestoreHealthLogsRequest_All
+	//	*RestoreHealthLogsRequest_Ids
+	Data isRestoreHealthLogsRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *RestoreHealthLogsRequest) Reset() {
+	*x = RestoreHealthLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreHealthLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreHealthLogsRequest) ProtoMessage() {}
+
+func (x *RestoreHealthLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreHealthLogsRequest.ProtoReflect.Descriptor instead.
+func (*RestoreHealthLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestoreHealthLogsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (m *RestoreHealthLogsRequest) GetData() isRestoreHealthLogsRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *RestoreHealthLogsRequest) GetAll() bool {
+	if x, ok := x.GetData().(*RestoreHealthLogsRequest_All); ok {
+		return x.All
+	}
+	return false
+}
+
+func (x *RestoreHealthLogsRequest) GetIds() *HealthLogIDs {
+	if x, ok := x.GetData().(*RestoreHealthLogsRequest_Ids); ok {
+		return x.Ids
+	}
+	return nil
+}
+
+type isRestoreHealthLogsRequest_Data interface {
+	isRestoreHealthLogsRequest_Data()
+}
+
+type RestoreHealthLogsRequest_All struct {
+	//all denotes if all of the deleted entries should be restored or not.
+	All bool `protobuf:"varint,2,opt,name=all,proto3,oneof"`
+}
+
+type RestoreHealthLogsRequest_Ids struct {
+	//ids denotes the IDs of the deleted entries to restore.
+	Ids *HealthLogIDs `protobuf:"bytes,3,opt,name=ids,proto3,oneof"`
+}
+
+func (*RestoreHealthLogsRequest_All) isRestoreHealthLogsRequest_Data() {}
+
+func (*RestoreHealthLogsRequest_Ids) isRestoreHealthLogsRequest_Data() {}
+
+type HealthLogIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *HealthLogIDs) Reset() {
+	*x = HealthLogIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthLogIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthLogIDs) ProtoMessage() {}
+
+func (x *HealthLogIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthLogIDs.ProtoReflect.Descriptor instead.
+func (*HealthLogIDs) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HealthLogIDs) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RestoreHealthLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//entriesRestored denotes the number of log entries that were moved out of the trash.
+	EntriesRestored uint32 `protobuf:"varint,1,opt,name=entriesRestored,proto3" json:"entriesRestored,omitempty"`
+}
+
+func (x *RestoreHealthLogsResponse) Reset() {
+	*x = RestoreHealthLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreHealthLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreHealthLogsResponse) ProtoMessage() {}
+
+func (x *RestoreHealthLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreHealthLogsResponse.ProtoReflect.Descriptor instead.
+func (*RestoreHealthLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreHealthLogsResponse) GetEntriesRestored() uint32 {
+	if x != nil {
+		return x.EntriesRestored
+	}
+	return 0
+}
+
+// A log entry as it was before an update changed it.
+type HealthLogRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//logID denotes the ID of the log entry the revision belongs to.
+	LogID string `protobuf:"bytes,1,opt,name=logID,proto3" json:"logID,omitempty"`
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	//version denotes the version of the log entry the revision holds. The update that replaced it produced version + 1.
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	//revisedAt denotes when the log entry was changed away from this revision.
+	RevisedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=revisedAt,proto3" json:"revisedAt,omitempty"`
+	//changedFields denotes the fields the update changed, out of logDate, score and journalName.
+	ChangedFields []string `protobuf:"bytes,5,rep,name=changedFields,proto3" json:"changedFields,omitempty"`
+	//healthLog denotes the log entry as it was at this revision.
+	HealthLog *MentalHealthLog `protobuf:"bytes,6,opt,name=healthLog,proto3" json:"healthLog,omitempty"`
+}
+
+func (x *HealthLogRevision) Reset() {
+	*x = HealthLogRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthLogRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthLogRevision) ProtoMessage() {}
+
+func (x *HealthLogRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthLogRevision.ProtoReflect.Descriptor instead.
+func (*HealthLogRevision) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HealthLogRevision) GetLogID() string {
+	if x != nil {
+		return x.LogID
+	}
+	return ""
+}
+
+func (x *HealthLogRevision) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *HealthLogRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *HealthLogRevision) GetRevisedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RevisedAt
+	}
+	return nil
+}
+
+func (x *HealthLogRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *HealthLogRevision) GetHealthLog() *MentalHealthLog {
+	if x != nil {
+		return x.HealthLog
+	}
+	return nil
+}
+
+// Request from a user to see the earlier revisions of a mental health log entry.
+type GetHealthLogRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the ID of the log entry whose revisions are returned.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetHealthLogRevisionsRequest) Reset() {
+	*x = GetHealthLogRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthLogRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthLogRevisionsRequest) ProtoMessage() {}
+
+func (x *GetHealthLogRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthLogRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthLogRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetHealthLogRevisionsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetHealthLogRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHealthLogRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//revisions denotes the earlier revisions of the log entry, most recent first.
+	Revisions []*HealthLogRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetHealthLogRevisionsResponse) Reset() {
+	*x = GetHealthLogRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthLogRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthLogRevisionsResponse) ProtoMessage() {}
+
+func (x *GetHealthLogRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthLogRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthLogRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetHealthLogRevisionsResponse) GetRevisions() []*HealthLogRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Request from a user to put a mental health log entry back the way it was at an earlier revision.
+type RevertHealthLogToRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//id denotes the ID of the log entry to revert.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//revisionVersion denotes the version of the revision to go back to.
+	RevisionVersion int64 `protobuf:"varint,3,opt,name=revisionVersion,proto3" json:"revisionVersion,omitempty"`
+	//expectedVersion denotes the version the log entry must still have for the revert to go through. The
+	//revert is unconditional when it is 0.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *RevertHealthLogToRevisionRequest) Reset() {
+	*x = RevertHealthLogToRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertHealthLogToRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertHealthLogToRevisionRequest) ProtoMessage() {}
+
+func (x *RevertHealthLogToRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertHealthLogToRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RevertHealthLogToRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevertHealthLogToRevisionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RevertHealthLogToRevisionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertHealthLogToRevisionRequest) GetRevisionVersion() int64 {
+	if x != nil {
+		return x.RevisionVersion
+	}
+	return 0
+}
+
+func (x *RevertHealthLogToRevisionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RevertHealthLogToRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	//version denotes the version of the log entry after the revert.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RevertHealthLogToRevisionResponse) Reset() {
+	*x = RevertHealthLogToRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertHealthLogToRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertHealthLogToRevisionResponse) ProtoMessage() {}
+
+func (x *RevertHealthLogToRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertHealthLogToRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RevertHealthLogToRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RevertHealthLogToRevisionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevertHealthLogToRevisionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Consent from a user for another user, such as a therapist or caregiver, to read their health data.
+type AccessGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//ownerID denotes the ID of the user whose health data is shared.
+	OwnerID int64 `protobuf:"varint,1,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	//granteeID denotes the ID of the user the health data is shared with.
+	GranteeID int64 `protobuf:"varint,2,opt,name=granteeID,proto3" json:"granteeID,omitempty"`
+	//scope denotes how much of the health data is shared.
+	Scope GrantScope `protobuf:"varint,3,opt,name=scope,proto3,enum=kic.health.GrantScope" json:"scope,omitempty"`
+	//createdAt denotes when the grant was given.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	//expiresAt denotes when the grant stops giving access.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *AccessGrant) Reset() {
+	*x = AccessGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessGrant) ProtoMessage() {}
+
+func (x *AccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessGrant.ProtoReflect.Descriptor instead.
+func (*AccessGrant) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccessGrant) GetOwnerID() int64 {
+	if x != nil {
+		return x.OwnerID
+	}
+	return 0
+}
+
+func (x *AccessGrant) GetGranteeID() int64 {
+	if x != nil {
+		return x.GranteeID
+	}
+	return 0
+}
+
+func (x *AccessGrant) GetScope() GrantScope {
+	if x != nil {
+		return x.Scope
+	}
+	return GrantScope_SCORES_ONLY
+}
+
+func (x *AccessGrant) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccessGrant) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Request from a user to share their health data with another user. Any earlier grant to the same user is replaced.
+type GrantAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//granteeID denotes the ID of the user to share the health data with.
+	GranteeID int64 `protobuf:"varint,2,opt,name=granteeID,proto3" json:"granteeID,omitempty"`
+	//scope denotes how much of the health data to share.
+	Scope GrantScope `protobuf:"varint,3,opt,name=scope,proto3,enum=kic.health.GrantScope" json:"scope,omitempty"`
+	//expiresAt denotes when the grant stops giving access. It must be in the future.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *GrantAccessRequest) Reset() {
+	*x = GrantAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAccessRequest) ProtoMessage() {}
+
+func (x *GrantAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GrantAccessRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GrantAccessRequest) GetGranteeID() int64 {
+	if x != nil {
+		return x.GranteeID
+	}
+	return 0
+}
+
+func (x *GrantAccessRequest) GetScope() GrantScope {
+	if x != nil {
+		return x.Scope
+	}
+	return GrantScope_SCORES_ONLY
+}
+
+func (x *GrantAccessRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GrantAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//grant denotes the access grant that was given.
+	Grant *AccessGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+}
+
+func (x *GrantAccessResponse) Reset() {
+	*x = GrantAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAccessResponse) ProtoMessage() {}
+
+func (x *GrantAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GrantAccessResponse) GetGrant() *AccessGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// Request from a user to stop sharing their health data with another user.
+type RevokeAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//granteeID denotes the ID of the user to stop sharing the health data with.
+	GranteeID int64 `protobuf:"varint,2,opt,name=granteeID,proto3" json:"granteeID,omitempty"`
+}
+
+func (x *RevokeAccessRequest) Reset() {
+	*x = RevokeAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessRequest) ProtoMessage() {}
+
+func (x *RevokeAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RevokeAccessRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RevokeAccessRequest) GetGranteeID() int64 {
+	if x != nil {
+		return x.GranteeID
+	}
+	return 0
+}
+
+type RevokeAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RevokeAccessResponse) Reset() {
+	*x = RevokeAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessResponse) ProtoMessage() {}
+
+func (x *RevokeAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevokeAccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request from a user to see the unexpired access grants they have given and received.
+type ListGrantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListGrantsRequest) Reset() {
+	*x = ListGrantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrantsRequest) ProtoMessage() {}
+
+func (x *ListGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListGrantsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ListGrantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//grantsGiven denotes the grants sharing the user's health data with others.
+	GrantsGiven []*AccessGrant `protobuf:"bytes,1,rep,name=grantsGiven,proto3" json:"grantsGiven,omitempty"`
+	//grantsReceived denotes the grants sharing other users' health data with the user.
+	GrantsReceived []*AccessGrant `protobuf:"bytes,2,rep,name=grantsReceived,proto3" json:"grantsReceived,omitempty"`
+}
+
+func (x *ListGrantsResponse) Reset() {
+	*x = ListGrantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrantsResponse) ProtoMessage() {}
+
+func (x *ListGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListGrantsResponse) GetGrantsGiven() []*AccessGrant {
+	if x != nil {
+		return x.GrantsGiven
+	}
+	return nil
+}
+
+func (x *ListGrantsResponse) GetGrantsReceived() []*AccessGrant {
+	if x != nil {
+		return x.GrantsReceived
+	}
+	return nil
+}
+
+// Request from a user for a copy of all of their health data.
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//format denotes how the records are encoded.
+	Format ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=kic.health.ExportFormat" json:"format,omitempty"`
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportUserDataRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ExportUserDataRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_NDJSON
+}
+
+// Describes an export. It is always the first message of the stream.
+type ExportManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//format denotes how the records are encoded.
+	Format ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=kic.health.ExportFormat" json:"format,omitempty"`
+	//exportedAt denotes when the export was started.
+	ExportedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=exportedAt,proto3" json:"exportedAt,omitempty"`
+	//recordTypes denotes the kinds of record in the export, in the order their first chunk is streamed. Chunks of revisions follow the chunk of logs they belong to, so they are interleaved with the live and trashed logs.
+	RecordTypes []*ExportRecordType `protobuf:"bytes,4,rep,name=recordTypes,proto3" json:"recordTypes,omitempty"`
+}
+
+func (x *ExportManifest) Reset() {
+	*x = ExportManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportManifest) ProtoMessage() {}
+
+func (x *ExportManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportManifest.ProtoReflect.Descriptor instead.
+func (*ExportManifest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportManifest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ExportManifest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_NDJSON
+}
+
+func (x *ExportManifest) GetExportedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *ExportManifest) GetRecordTypes() []*ExportRecordType {
+	if x != nil {
+		return x.RecordTypes
+	}
+	return nil
+}
+
+type ExportRecordType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name denotes the name of the record type, as used in ExportChunk.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//description denotes what the records hold.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	//columns denotes the fields of each record, the CSV header for CSV exports.
+	Columns []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *ExportRecordType) Reset() {
+	*x = ExportRecordType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRecordType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecordType) ProtoMessage() {}
+
+func (x *ExportRecordType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecordType.ProtoReflect.Descriptor instead.
+func (*ExportRecordType) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportRecordType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportRecordType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExportRecordType) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// A run of encoded records of one type. Concatenating the data of every chunk of a type gives a complete file.
+type ExportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//recordType denotes the name of the type of the records in the chunk.
+	RecordType string `protobuf:"bytes,1,opt,name=recordType,proto3" json:"recordType,omitempty"`
+	//data denotes the encoded records.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	//numRecords denotes the number of records in the chunk.
+	NumRecords uint32 `protobuf:"varint,3,opt,name=numRecords,proto3" json:"numRecords,omitempty"`
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportChunk) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetNumRecords() uint32 {
+	if x != nil {
+		return x.NumRecords
+	}
+	return 0
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//The manifest comes first, followed by the chunks of records
+	//
+	// Types that are assignable to Data:
+	//	*ExportUserDataResponse_Manifest
+	//	*ExportUserDataResponse_Chunk
+	Data isExportUserDataResponse_Data `protobuf_oneof:"data"`
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{48}
+}
+
+func (m *ExportUserDataResponse) GetData() isExportUserDataResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetManifest() *ExportManifest {
+	if x, ok := x.GetData().(*ExportUserDataResponse_Manifest); ok {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetChunk() *ExportChunk {
+	if x, ok := x.GetData().(*ExportUserDataResponse_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isExportUserDataResponse_Data interface {
+	isExportUserDataResponse_Data()
+}
+
+type ExportUserDataResponse_Manifest struct {
+	Manifest *ExportManifest `protobuf:"bytes,1,opt,name=manifest,proto3,oneof"`
+}
+
+type ExportUserDataResponse_Chunk struct {
+	Chunk *ExportChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ExportUserDataResponse_Manifest) isExportUserDataResponse_Data() {}
+
+func (*ExportUserDataResponse_Chunk) isExportUserDataResponse_Data() {}
+
+type ImportHealthDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//healthData denotes a batch of mental health logs to import. Rows are numbered across every batch of the stream,
+	//starting at 0.
+	HealthData []*MentalHealthLog `protobuf:"bytes,2,rep,name=healthData,proto3" json:"healthData,omitempty"`
+}
+
+func (x *ImportHealthDataRequest) Reset() {
+	*x = ImportHealthDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportHealthDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHealthDataRequest) ProtoMessage() {}
+
+func (x *ImportHealthDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHealthDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportHealthDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportHealthDataRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ImportHealthDataRequest) GetHealthData() []*MentalHealthLog {
+	if x != nil {
+		return x.HealthData
+	}
+	return nil
+}
+
+// Describes a row that was not imported.
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//row denotes the number of the row in the stream or export, counting from 0.
+	Row uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	//reason denotes why the row was not imported.
+	Reason ImportRowErrorReason `protobuf:"varint,2,opt,name=reason,proto3,enum=kic.health.ImportRowErrorReason" json:"reason,omitempty"`
+	//message denotes what was wrong with the row.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportRowError) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetReason() ImportRowErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ImportRowErrorReason_INVALID
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportHealthDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//numImported denotes how many rows were added.
+	NumImported uint32 `protobuf:"varint,1,opt,name=numImported,proto3" json:"numImported,omitempty"`
+	//ids denotes the IDs of the added log entries, in row order.
+	Ids *HealthLogIDs `protobuf:"bytes,2,opt,name=ids,proto3" json:"ids,omitempty"`
+	//errors denotes the rows that were not imported, in row order.
+	Errors []*ImportRowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportHealthDataResponse) Reset() {
+	*x = ImportHealthDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportHealthDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHealthDataResponse) ProtoMessage() {}
+
+func (x *ImportHealthDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHealthDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportHealthDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportHealthDataResponse) GetNumImported() uint32 {
+	if x != nil {
+		return x.NumImported
+	}
+	return 0
+}
+
+func (x *ImportHealthDataResponse) GetIds() *HealthLogIDs {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ImportHealthDataResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportExternalHealthDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//format denotes the name of the app the data was exported from, such as daylio, emoods or applehealth.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	//data denotes the contents of the exported file, at most 64 MiB.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ImportExternalHealthDataRequest) Reset() {
+	*x = ImportExternalHealthDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportExternalHealthDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExternalHealthDataRequest) ProtoMessage() {}
+
+func (x *ImportExternalHealthDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExternalHealthDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportExternalHealthDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportExternalHealthDataRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ImportExternalHealthDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportExternalHealthDataRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetHealthDataAsFHIRRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//startDate and endDate denote the inclusive range of dates to convert. Every entry is converted if both are unset.
+	StartDate *common.Date `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   *common.Date `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	//includeJournals denotes whether journal entries are added to the observations as notes.
+	IncludeJournals bool `protobuf:"varint,4,opt,name=includeJournals,proto3" json:"includeJournals,omitempty"`
+}
+
+func (x *GetHealthDataAsFHIRRequest) Reset() {
+	*x = GetHealthDataAsFHIRRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthDataAsFHIRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthDataAsFHIRRequest) ProtoMessage() {}
+
+func (x *GetHealthDataAsFHIRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthDataAsFHIRRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthDataAsFHIRRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetHealthDataAsFHIRRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetHealthDataAsFHIRRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetHealthDataAsFHIRRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetHealthDataAsFHIRRequest) GetIncludeJournals() bool {
+	if x != nil {
+		return x.IncludeJournals
+	}
+	return false
+}
+
+type GetHealthDataAsFHIRResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//bundle denotes a FHIR R4 collection Bundle of Observation resources as JSON, one per entry followed by their mean.
+	Bundle string `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (x *GetHealthDataAsFHIRResponse) Reset() {
+	*x = GetHealthDataAsFHIRResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthDataAsFHIRResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthDataAsFHIRResponse) ProtoMessage() {}
+
+func (x *GetHealthDataAsFHIRResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthDataAsFHIRResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthDataAsFHIRResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetHealthDataAsFHIRResponse) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+// A completed standardized questionnaire and its score
+type Assessment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id denotes the unique ID of the assessment, assigned when it is submitted.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	//type denotes the questionnaire that was answered.
+	Type AssessmentType `protobuf:"varint,3,opt,name=type,proto3,enum=kic.health.AssessmentType" json:"type,omitempty"`
+	//assessmentDate denotes the day the questionnaire was answered.
+	AssessmentDate *common.Date `protobuf:"bytes,4,opt,name=assessmentDate,proto3" json:"assessmentDate,omitempty"`
+	//submittedAt denotes when the assessment was submitted.
+	SubmittedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"`
+	//responses denotes the answer to each item in order, from 0 (not at all) to 3 (nearly every day).
+	Responses []int32 `protobuf:"varint,6,rep,packed,name=responses,proto3" json:"responses,omitempty"`
+	//totalScore denotes the sum of the responses.
+	TotalScore int32 `protobuf:"varint,7,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+	//severity denotes the band the total score falls in.
+	Severity AssessmentSeverity `protobuf:"varint,8,opt,name=severity,proto3,enum=kic.health.AssessmentSeverity" json:"severity,omitempty"`
+	//riskFlagged denotes that the item about thoughts of self harm was answered above 0, which calls for follow-up.
+	RiskFlagged bool `protobuf:"varint,9,opt,name=riskFlagged,proto3" json:"riskFlagged,omitempty"`
+}
+
+func (x *Assessment) Reset() {
+	*x = Assessment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessment) ProtoMessage() {}
+
+func (x *Assessment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessment.ProtoReflect.Descriptor instead.
+func (*Assessment) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Assessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assessment) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Assessment) GetType() AssessmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssessmentType_PHQ9
+}
+
+func (x *Assessment) GetAssessmentDate() *common.Date {
+	if x != nil {
+		return x.AssessmentDate
+	}
+	return nil
+}
+
+func (x *Assessment) GetSubmittedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Assessment) GetResponses() []int32 {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *Assessment) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *Assessment) GetSeverity() AssessmentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AssessmentSeverity_MINIMAL
+}
+
+func (x *Assessment) GetRiskFlagged() bool {
+	if x != nil {
+		return x.RiskFlagged
+	}
+	return false
+}
+
+type SubmitAssessmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//type denotes the questionnaire that was answered.
+	Type AssessmentType `protobuf:"varint,2,opt,name=type,proto3,enum=kic.health.AssessmentType" json:"type,omitempty"`
+	//assessmentDate denotes the day the questionnaire was answered.
+	AssessmentDate *common.Date `protobuf:"bytes,3,opt,name=assessmentDate,proto3" json:"assessmentDate,omitempty"`
+	//responses denotes the answer to each item in order, from 0 (not at all) to 3 (nearly every day).
+	Responses []int32 `protobuf:"varint,4,rep,packed,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *SubmitAssessmentRequest) Reset() {
+	*x = SubmitAssessmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAssessmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssessmentRequest) ProtoMessage() {}
+
+func (x *SubmitAssessmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssessmentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAssessmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SubmitAssessmentRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmitAssessmentRequest) GetType() AssessmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssessmentType_PHQ9
+}
+
+func (x *SubmitAssessmentRequest) GetAssessmentDate() *common.Date {
+	if x != nil {
+		return x.AssessmentDate
+	}
+	return nil
+}
+
+func (x *SubmitAssessmentRequest) GetResponses() []int32 {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type SubmitAssessmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//assessment denotes the stored assessment with its score.
+	Assessment *Assessment `protobuf:"bytes,1,opt,name=assessment,proto3" json:"assessment,omitempty"`
+}
+
+func (x *SubmitAssessmentResponse) Reset() {
+	*x = SubmitAssessmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAssessmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssessmentResponse) ProtoMessage() {}
+
+func (x *SubmitAssessmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssessmentResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAssessmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SubmitAssessmentResponse) GetAssessment() *Assessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+type ListAssessmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//types denotes the questionnaires to list. Every type is listed if it is empty.
+	Types []AssessmentType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=kic.health.AssessmentType" json:"types,omitempty"`
+	//startDate and endDate denote the inclusive range of dates to list. Either can be unset to leave that end open.
+	StartDate *common.Date `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   *common.Date `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
+}
+
+func (x *ListAssessmentsRequest) Reset() {
+	*x = ListAssessmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentsRequest) ProtoMessage() {}
+
+func (x *ListAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAssessmentsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ListAssessmentsRequest) GetTypes() []AssessmentType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListAssessmentsRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListAssessmentsRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type ListAssessmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//assessments denotes the assessments, oldest first.
+	Assessments []*Assessment `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+}
+
+func (x *ListAssessmentsResponse) Reset() {
+	*x = ListAssessmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAssessmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentsResponse) ProtoMessage() {}
+
+func (x *ListAssessmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAssessmentsResponse) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+type GetAssessmentTrendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//type denotes the questionnaire to follow.
+	Type AssessmentType `protobuf:"varint,2,opt,name=type,proto3,enum=kic.health.AssessmentType" json:"type,omitempty"`
+	//startDate and endDate denote the inclusive range of dates to follow. Either can be unset to leave that end open.
+	StartDate *common.Date `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   *common.Date `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
+}
+
+func (x *GetAssessmentTrendRequest) Reset() {
+	*x = GetAssessmentTrendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssessmentTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentTrendRequest) ProtoMessage() {}
+
+func (x *GetAssessmentTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentTrendRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAssessmentTrendRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetAssessmentTrendRequest) GetType() AssessmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssessmentType_PHQ9
+}
+
+func (x *GetAssessmentTrendRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetAssessmentTrendRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type AssessmentTrendPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//assessmentDate denotes the day the questionnaire was answered.
+	AssessmentDate *common.Date `protobuf:"bytes,1,opt,name=assessmentDate,proto3" json:"assessmentDate,omitempty"`
+	//totalScore denotes the total score of the assessment.
+	TotalScore int32 `protobuf:"varint,2,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+	//severity denotes the band the total score falls in.
+	Severity AssessmentSeverity `protobuf:"varint,3,opt,name=severity,proto3,enum=kic.health.AssessmentSeverity" json:"severity,omitempty"`
+}
+
+func (x *AssessmentTrendPoint) Reset() {
+	*x = AssessmentTrendPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessmentTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentTrendPoint) ProtoMessage() {}
+
+func (x *AssessmentTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentTrendPoint.ProtoReflect.Descriptor instead.
+func (*AssessmentTrendPoint) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AssessmentTrendPoint) GetAssessmentDate() *common.Date {
+	if x != nil {
+		return x.AssessmentDate
+	}
+	return nil
+}
+
+func (x *AssessmentTrendPoint) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *AssessmentTrendPoint) GetSeverity() AssessmentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AssessmentSeverity_MINIMAL
+}
+
+type GetAssessmentTrendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//points denotes the score of each assessment, oldest first.
+	Points []*AssessmentTrendPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	//change denotes the last total score minus the first. A negative change is an improvement.
+	Change int32 `protobuf:"varint,2,opt,name=change,proto3" json:"change,omitempty"`
+	//reliableChange denotes that the change is large enough to be unlikely to be measurement error.
+	ReliableChange bool `protobuf:"varint,3,opt,name=reliableChange,proto3" json:"reliableChange,omitempty"`
+}
+
+func (x *GetAssessmentTrendResponse) Reset() {
+	*x = GetAssessmentTrendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssessmentTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentTrendResponse) ProtoMessage() {}
+
+func (x *GetAssessmentTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetAssessmentTrendResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAssessmentTrendResponse) GetPoints() []*AssessmentTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetAssessmentTrendResponse) GetChange() int32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *GetAssessmentTrendResponse) GetReliableChange() bool {
+	if x != nil {
+		return x.ReliableChange
+	}
+	return false
+}
+
+type SurveyChoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id denotes the ID of the choice, unique within its question.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//text denotes what the choice says.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	//value denotes what choosing it adds to the question's value in formulas.
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SurveyChoice) Reset() {
+	*x = SurveyChoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyChoice) ProtoMessage() {}
+
+func (x *SurveyChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyChoice.ProtoReflect.Descriptor instead.
+func (*SurveyChoice) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SurveyChoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SurveyChoice) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SurveyChoice) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SurveyQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id denotes the ID of the question, unique within its survey. Formulas and conditions refer to questions by it, so
+	//it must start with a letter and hold only letters, digits and underscores.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//text denotes what the question asks.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	//type denotes how the question is answered.
+	Type QuestionType `protobuf:"varint,3,opt,name=type,proto3,enum=kic.health.QuestionType" json:"type,omitempty"`
+	//minValue and maxValue denote the inclusive range of a scale question.
+	MinValue int32 `protobuf:"varint,4,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	MaxValue int32 `protobuf:"varint,5,opt,name=maxValue,proto3" json:"maxValue,omitempty"`
+	//choices denotes the options of a multiple choice question.
+	Choices []*SurveyChoice `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
+	//allowMultiple denotes whether more than one choice can be picked.
+	AllowMultiple bool `protobuf:"varint,7,opt,name=allowMultiple,proto3" json:"allowMultiple,omitempty"`
+	//required denotes whether the question must be answered when it is asked.
+	Required bool `protobuf:"varint,8,opt,name=required,proto3" json:"required,omitempty"`
+	//showIf denotes a condition on the answers to earlier questions. The question is only asked when it holds, or always
+	//if it is empty.
+	ShowIf string `protobuf:"bytes,9,opt,name=showIf,proto3" json:"showIf,omitempty"`
+}
+
+func (x *SurveyQuestion) Reset() {
+	*x = SurveyQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyQuestion) ProtoMessage() {}
+
+func (x *SurveyQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyQuestion.ProtoReflect.Descriptor instead.
+func (*SurveyQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SurveyQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SurveyQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SurveyQuestion) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_SCALE
+}
+
+func (x *SurveyQuestion) GetMinValue() int32 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *SurveyQuestion) GetMaxValue() int32 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+func (x *SurveyQuestion) GetChoices() []*SurveyChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *SurveyQuestion) GetAllowMultiple() bool {
+	if x != nil {
+		return x.AllowMultiple
+	}
+	return false
+}
+
+func (x *SurveyQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *SurveyQuestion) GetShowIf() string {
+	if x != nil {
+		return x.ShowIf
+	}
+	return ""
+}
+
+type SurveyScoreFormula struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name denotes the name of the score.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//formula denotes an arithmetic expression over question IDs, such as "sum(q1, q2) * 2".
+	Formula string `protobuf:"bytes,2,opt,name=formula,proto3" json:"formula,omitempty"`
+}
+
+func (x *SurveyScoreFormula) Reset() {
+	*x = SurveyScoreFormula{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyScoreFormula) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyScoreFormula) ProtoMessage() {}
+
+func (x *SurveyScoreFormula) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyScoreFormula.ProtoReflect.Descriptor instead.
+func (*SurveyScoreFormula) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SurveyScoreFormula) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SurveyScoreFormula) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+// A published version of a survey. Publishing a survey again with the same ID makes a new version.
+type Survey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id denotes the ID of the survey, chosen by its author.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//version denotes the version of the survey, assigned when it is published starting at 1.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	//title denotes the name of the survey shown to users.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	//description denotes what the survey is for.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	//questions denotes the questions of the survey, in the order they are asked.
+	Questions []*SurveyQuestion `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	//scores denotes the scores computed from the answers.
+	Scores []*SurveyScoreFormula `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`
+	//publishedBy denotes the ID of the user who published the version.
+	PublishedBy int64 `protobuf:"varint,7,opt,name=publishedBy,proto3" json:"publishedBy,omitempty"`
+	//publishedAt denotes when the version was published.
+	PublishedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+}
+
+func (x *Survey) Reset() {
+	*x = Survey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Survey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Survey) ProtoMessage() {}
+
+func (x *Survey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Survey.ProtoReflect.Descriptor instead.
+func (*Survey) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Survey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Survey) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Survey) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Survey) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Survey) GetQuestions() []*SurveyQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Survey) GetScores() []*SurveyScoreFormula {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Survey) GetPublishedBy() int64 {
+	if x != nil {
+		return x.PublishedBy
+	}
+	return 0
+}
+
+func (x *Survey) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type SurveyAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//questionID denotes the ID of the question answered.
+	QuestionID string `protobuf:"bytes,1,opt,name=questionID,proto3" json:"questionID,omitempty"`
+	//value denotes the answer to a scale question.
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	//choiceIDs denotes the choices picked for a multiple choice question.
+	ChoiceIDs []string `protobuf:"bytes,3,rep,name=choiceIDs,proto3" json:"choiceIDs,omitempty"`
+	//text denotes the answer to a free text question.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SurveyAnswer) Reset() {
+	*x = SurveyAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyAnswer) ProtoMessage() {}
+
+func (x *SurveyAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyAnswer.ProtoReflect.Descriptor instead.
+func (*SurveyAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SurveyAnswer) GetQuestionID() string {
+	if x != nil {
+		return x.QuestionID
+	}
+	return ""
+}
+
+func (x *SurveyAnswer) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SurveyAnswer) GetChoiceIDs() []string {
+	if x != nil {
+		return x.ChoiceIDs
+	}
+	return nil
+}
+
+func (x *SurveyAnswer) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SurveyScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name denotes the name of the score in the survey.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//value denotes the result of its formula.
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SurveyScore) Reset() {
+	*x = SurveyScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyScore) ProtoMessage() {}
+
+func (x *SurveyScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyScore.ProtoReflect.Descriptor instead.
+func (*SurveyScore) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SurveyScore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SurveyScore) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SurveyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id denotes the unique ID of the response, assigned when it is submitted.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	//surveyID and surveyVersion denote the survey that was answered.
+	SurveyID      string `protobuf:"bytes,3,opt,name=surveyID,proto3" json:"surveyID,omitempty"`
+	SurveyVersion int64  `protobuf:"varint,4,opt,name=surveyVersion,proto3" json:"surveyVersion,omitempty"`
+	//submittedAt denotes when the response was submitted.
+	SubmittedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"`
+	//answers denotes the answers to the questions that were asked.
+	Answers []*SurveyAnswer `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	//scores denotes the scores of the survey, in the order they are defined.
+	Scores []*SurveyScore `protobuf:"bytes,7,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *SurveyResponse) Reset() {
+	*x = SurveyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurveyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyResponse) ProtoMessage() {}
+
+func (x *SurveyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyResponse.ProtoReflect.Descriptor instead.
+func (*SurveyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SurveyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SurveyResponse) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SurveyResponse) GetSurveyID() string {
+	if x != nil {
+		return x.SurveyID
+	}
+	return ""
+}
+
+func (x *SurveyResponse) GetSurveyVersion() int64 {
+	if x != nil {
+		return x.SurveyVersion
+	}
+	return 0
+}
+
+func (x *SurveyResponse) GetSubmittedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *SurveyResponse) GetAnswers() []*SurveyAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *SurveyResponse) GetScores() []*SurveyScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type GetSurveyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//surveyID denotes the ID of the survey.
+	SurveyID string `protobuf:"bytes,1,opt,name=surveyID,proto3" json:"surveyID,omitempty"`
+	//version denotes the version to get. The latest version is returned if it is 0.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetSurveyRequest) Reset() {
+	*x = GetSurveyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSurveyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurveyRequest) ProtoMessage() {}
+
+func (x *GetSurveyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurveyRequest.ProtoReflect.Descriptor instead.
+func (*GetSurveyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSurveyRequest) GetSurveyID() string {
+	if x != nil {
+		return x.SurveyID
+	}
+	return ""
+}
+
+func (x *GetSurveyRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetSurveyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Survey *Survey `protobuf:"bytes,1,opt,name=survey,proto3" json:"survey,omitempty"`
+}
+
+func (x *GetSurveyResponse) Reset() {
+	*x = GetSurveyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSurveyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurveyResponse) ProtoMessage() {}
+
+func (x *GetSurveyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurveyResponse.ProtoReflect.Descriptor instead.
+func (*GetSurveyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSurveyResponse) GetSurvey() *Survey {
+	if x != nil {
+		return x.Survey
+	}
+	return nil
+}
+
+type SubmitSurveyResponseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//surveyID and surveyVersion denote the survey that was answered. The latest version is used if surveyVersion is 0.
+	SurveyID      string `protobuf:"bytes,2,opt,name=surveyID,proto3" json:"surveyID,omitempty"`
+	SurveyVersion int64  `protobuf:"varint,3,opt,name=surveyVersion,proto3" json:"surveyVersion,omitempty"`
+	//answers denotes the answers to the questions that were asked.
+	Answers []*SurveyAnswer `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+}
+
+func (x *SubmitSurveyResponseRequest) Reset() {
+	*x = SubmitSurveyResponseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSurveyResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyResponseRequest) ProtoMessage() {}
+
+func (x *SubmitSurveyResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyResponseRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyResponseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SubmitSurveyResponseRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SubmitSurveyResponseRequest) GetSurveyID() string {
+	if x != nil {
+		return x.SurveyID
+	}
+	return ""
+}
+
+func (x *SubmitSurveyResponseRequest) GetSurveyVersion() int64 {
+	if x != nil {
+		return x.SurveyVersion
+	}
+	return 0
+}
+
+func (x *SubmitSurveyResponseRequest) GetAnswers() []*SurveyAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type SubmitSurveyResponseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//response denotes the stored response with its scores.
+	Response *SurveyResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *SubmitSurveyResponseResponse) Reset() {
+	*x = SubmitSurveyResponseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSurveyResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyResponseResponse) ProtoMessage() {}
+
+func (x *SubmitSurveyResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyResponseResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyResponseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SubmitSurveyResponseResponse) GetResponse() *SurveyResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ListSurveyResponsesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//surveyID denotes the survey to list responses to. Responses to every survey are listed if it is empty.
+	SurveyID string `protobuf:"bytes,2,opt,name=surveyID,proto3" json:"surveyID,omitempty"`
+}
+
+func (x *ListSurveyResponsesRequest) Reset() {
+	*x = ListSurveyResponsesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSurveyResponsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSurveyResponsesRequest) ProtoMessage() {}
+
+func (x *ListSurveyResponsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSurveyResponsesRequest.ProtoReflect.Descriptor instead.
+func (*ListSurveyResponsesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListSurveyResponsesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ListSurveyResponsesRequest) GetSurveyID() string {
+	if x != nil {
+		return x.SurveyID
+	}
+	return ""
+}
+
+type ListSurveyResponsesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//responses denotes the responses, oldest first.
+	Responses []*SurveyResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ListSurveyResponsesResponse) Reset() {
+	*x = ListSurveyResponsesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSurveyResponsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSurveyResponsesResponse) ProtoMessage() {}
+
+func (x *ListSurveyResponsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSurveyResponsesResponse.ProtoReflect.Descriptor instead.
+func (*ListSurveyResponsesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListSurveyResponsesResponse) GetResponses() []*SurveyResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// A mood dimension that logs can rate
+type MoodDimension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name denotes the name used in DimensionScore.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//description denotes what the dimension rates.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	//minScore and maxScore denote the inclusive range of ratings.
+	MinScore int32 `protobuf:"varint,3,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	MaxScore int32 `protobuf:"varint,4,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+}
+
+func (x *MoodDimension) Reset() {
+	*x = MoodDimension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoodDimension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoodDimension) ProtoMessage() {}
+
+func (x *MoodDimension) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoodDimension.ProtoReflect.Descriptor instead.
+func (*MoodDimension) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MoodDimension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoodDimension) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MoodDimension) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *MoodDimension) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type ListMoodDimensionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMoodDimensionsRequest) Reset() {
+	*x = ListMoodDimensionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMoodDimensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoodDimensionsRequest) ProtoMessage() {}
+
+func (x *ListMoodDimensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoodDimensionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMoodDimensionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{77}
+}
+
+type ListMoodDimensionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dimensions []*MoodDimension `protobuf:"bytes,1,rep,name=dimensions,proto3" json:"dimensions,omitempty"`
+}
+
+func (x *ListMoodDimensionsResponse) Reset() {
+	*x = ListMoodDimensionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMoodDimensionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMoodDimensionsResponse) ProtoMessage() {}
+
+func (x *ListMoodDimensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMoodDimensionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMoodDimensionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListMoodDimensionsResponse) GetDimensions() []*MoodDimension {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+// A tag in a user's vocabulary, and how often their logs use it
+type TagUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name denotes the tag as it appears on logs.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//count denotes the number of logs, not in the trash, with the tag.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	//lastUsed denotes the latest log date with the tag.
+	LastUsed *common.Date `protobuf:"bytes,3,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
+}
+
+func (x *TagUsage) Reset() {
+	*x = TagUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagUsage) ProtoMessage() {}
+
+func (x *TagUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagUsage.ProtoReflect.Descriptor instead.
+func (*TagUsage) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TagUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagUsage) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TagUsage) GetLastUsed() *common.Date {
+	if x != nil {
+		return x.LastUsed
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListTagsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tags denotes the user's tag vocabulary, most used first.
+	Tags []*TagUsage `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagUsage {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Request from a user to rename a tag on every one of their logs, including those in the trash.
+// Every log changed moves to a new version and keeps its previous tags as a revision.
+type RenameTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//from denotes the current name of the tag.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	//to denotes the new name. It must not already be in use, use MergeTags for that.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RenameTagRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RenameTagRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//entriesUpdated denotes the logs that had the tag renamed.
+	EntriesUpdated uint32 `protobuf:"varint,1,opt,name=entriesUpdated,proto3" json:"entriesUpdated,omitempty"`
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RenameTagResponse) GetEntriesUpdated() uint32 {
+	if x != nil {
+		return x.EntriesUpdated
+	}
+	return 0
+}
+
+// Request from a user to replace several tags with one on every one of their logs, including those in the trash.
+// Every log changed moves to a new version and keeps its previous tags as a revision.
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//sources denotes the tags to replace.
+	Sources []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	//target denotes the tag replacing them, which may be new or already in use.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MergeTagsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MergeTagsRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//entriesUpdated denotes the logs that had a tag replaced.
+	EntriesUpdated uint32 `protobuf:"varint,1,opt,name=entriesUpdated,proto3" json:"entriesUpdated,omitempty"`
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MergeTagsResponse) GetEntriesUpdated() uint32 {
+	if x != nil {
+		return x.EntriesUpdated
+	}
+	return 0
+}
+
+// Request from a user, or someone they granted access to, to compare scores on days with and without each tag
+type GetTagImpactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	//startDate denotes the first date of the range, inclusive.
+	StartDate *common.Date `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	//endDate denotes the last date of the range, inclusive.
+	EndDate *common.Date `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+}
+
+func (x *GetTagImpactRequest) Reset() {
+	*x = GetTagImpactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagImpactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagImpactRequest) ProtoMessage() {}
+
+func (x *GetTagImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetTagImpactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetTagImpactRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetTagImpactRequest) GetStartDate() *common.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTagImpactRequest) GetEndDate() *common.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// The average daily score on days with and without a tag. A day's score is the mean of its logs.
+type TagImpact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//daysWith denotes the days in the range with a log carrying the tag.
+	DaysWith uint32  `protobuf:"varint,2,opt,name=daysWith,proto3" json:"daysWith,omitempty"`
+	MeanWith float64 `protobuf:"fixed64,3,opt,name=meanWith,proto3" json:"meanWith,omitempty"`
+	//daysWithout denotes the days in the range with logs, none of which carry the tag.
+	DaysWithout uint32  `protobuf:"varint,4,opt,name=daysWithout,proto3" json:"daysWithout,omitempty"`
+	MeanWithout float64 `protobuf:"fixed64,5,opt,name=meanWithout,proto3" json:"meanWithout,omitempty"`
+	//difference denotes meanWith minus meanWithout, 0 if either has no days.
+	Difference float64 `protobuf:"fixed64,6,opt,name=difference,proto3" json:"difference,omitempty"`
+}
+
+func (x *TagImpact) Reset() {
+	*x = TagImpact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagImpact) ProtoMessage() {}
+
+func (x *TagImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagImpact.ProtoReflect.Descriptor instead.
+func (*TagImpact) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TagImpact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagImpact) GetDaysWith() uint32 {
+	if x != nil {
+		return x.DaysWith
+	}
+	return 0
+}
+
+func (x *TagImpact) GetMeanWith() float64 {
+	if x != nil {
+		return x.MeanWith
+	}
+	return 0
+}
+
+func (x *TagImpact) GetDaysWithout() uint32 {
+	if x != nil {
+		return x.DaysWithout
+	}
+	return 0
+}
+
+func (x *TagImpact) GetMeanWithout() float64 {
+	if x != nil {
+		return x.MeanWithout
+	}
+	return 0
+}
+
+func (x *TagImpact) GetDifference() float64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+type GetTagImpactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tags denotes every tag used in the range, most used first.
+	Tags []*TagImpact `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetTagImpactResponse) Reset() {
+	*x = GetTagImpactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagImpactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagImpactResponse) ProtoMessage() {}
+
+func (x *GetTagImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetTagImpactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetTagImpactResponse) GetTags() []*TagImpact {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Request from an administrator to recompute the running score totals kept for users from their mental health logs.
+type RebuildScoreAggregatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//userIDs denotes the users whose totals are rebuilt. Totals for every user are rebuilt when it is empty.
+	UserIDs []int64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+}
+
+func (x *RebuildScoreAggregatesRequest) Reset() {
+	*x = RebuildScoreAggregatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebuildScoreAggregatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildScoreAggregatesRequest) ProtoMessage() {}
+
+func (x *RebuildScoreAggregatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildScoreAggregatesRequest.ProtoReflect.Descriptor instead.
+func (*RebuildScoreAggregatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RebuildScoreAggregatesRequest) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type RebuildScoreAggregatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//usersRebuilt denotes the number of users whose totals were recomputed.
+	UsersRebuilt uint32 `protobuf:"varint,1,opt,name=usersRebuilt,proto3" json:"usersRebuilt,omitempty"`
+}
+
+func (x *RebuildScoreAggregatesResponse) Reset() {
+	*x = RebuildScoreAggregatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebuildScoreAggregatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildScoreAggregatesResponse) ProtoMessage() {}
+
+func (x *RebuildScoreAggregatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildScoreAggregatesResponse.ProtoReflect.Descriptor instead.
+func (*RebuildScoreAggregatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RebuildScoreAggregatesResponse) GetUsersRebuilt() uint32 {
+	if x != nil {
+		return x.UsersRebuilt
+	}
+	return 0
+}
+
+// Request from an administrator to give mental health logs stored before entries had a time a loggedAt and time zone.
+type MigrateLogTimesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//timeZone denotes the IANA time zone assumed for the logs. UTC is used when it is empty.
+	TimeZone string `protobuf:"bytes,1,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+}
+
+func (x *MigrateLogTimesRequest) Reset() {
+	*x = MigrateLogTimesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateLogTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateLogTimesRequest) ProtoMessage() {}
+
+func (x *MigrateLogTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateLogTimesRequest.ProtoReflect.Descriptor instead.
+func (*MigrateLogTimesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MigrateLogTimesRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type MigrateLogTimesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//entriesMigrated denotes the logs given the start of their date as loggedAt.
+	EntriesMigrated uint32 `protobuf:"varint,1,opt,name=entriesMigrated,proto3" json:"entriesMigrated,omitempty"`
+}
+
+func (x *MigrateLogTimesResponse) Reset() {
+	*x = MigrateLogTimesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateLogTimesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateLogTimesResponse) ProtoMessage() {}
+
+func (x *MigrateLogTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateLogTimesResponse.ProtoReflect.Descriptor instead.
+func (*MigrateLogTimesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MigrateLogTimesResponse) GetEntriesMigrated() uint32 {
+	if x != nil {
+		return x.EntriesMigrated
+	}
+	return 0
+}
+
+// Request from an administrator to permanently delete everything held about a user. Their data key is destroyed
+// first, so copies of their journals in backups can no longer be read.
+type ShredUserDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the user in the user database, used globally for identification.
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ShredUserDataRequest) Reset() {
+	*x = ShredUserDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShredUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredUserDataRequest) ProtoMessage() {}
+
+func (x *ShredUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ShredUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ShredUserDataRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ShredUserDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//entriesDeleted denotes the number of log entries that were removed, deleted ones included.
+	EntriesDeleted uint32 `protobuf:"varint,1,opt,name=entriesDeleted,proto3" json:"entriesDeleted,omitempty"`
+	//assessmentsDeleted denotes the number of assessments that were removed.
+	AssessmentsDeleted uint32 `protobuf:"varint,2,opt,name=assessmentsDeleted,proto3" json:"assessmentsDeleted,omitempty"`
+	//surveyResponsesDeleted denotes the number of survey responses that were removed.
+	SurveyResponsesDeleted uint32 `protobuf:"varint,3,opt,name=surveyResponsesDeleted,proto3" json:"surveyResponsesDeleted,omitempty"`
+}
+
+func (x *ShredUserDataResponse) Reset() {
+	*x = ShredUserDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShredUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShredUserDataResponse) ProtoMessage() {}
+
+func (x *ShredUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShredUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ShredUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ShredUserDataResponse) GetEntriesDeleted() uint32 {
+	if x != nil {
+		return x.EntriesDeleted
+	}
+	return 0
+}
+
+func (x *ShredUserDataResponse) GetAssessmentsDeleted() uint32 {
+	if x != nil {
+		return x.AssessmentsDeleted
+	}
+	return 0
+}
+
+func (x *ShredUserDataResponse) GetSurveyResponsesDeleted() uint32 {
+	if x != nil {
+		return x.SurveyResponsesDeleted
+	}
+	return 0
+}
+
+// A record of one call to the health service: who made it, whose data it was for and what came of it.
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//time denotes when the call was made.
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	//actorID denotes the ID of the authenticated user who made the call.
+	ActorID int64 `protobuf:"varint,2,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	//targetUserID denotes the ID of the user whose health data the call was for, 0 if it was for no single user.
+	TargetUserID int64 `protobuf:"varint,3,opt,name=targetUserID,proto3" json:"targetUserID,omitempty"`
+	//method denotes the full name of the RPC that was called.
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	//entryIDs denotes the IDs of the log entries the call read or changed.
+	EntryIDs []string `protobuf:"bytes,5,rep,name=entryIDs,proto3" json:"entryIDs,omitempty"`
+	//outcome denotes the gRPC status code the call finished with, such as OK or PermissionDenied.
+	Outcome string `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AuditEvent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetTargetUserID() int64 {
+	if x != nil {
+		return x.TargetUserID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntryIDs() []string {
+	if x != nil {
+		return x.EntryIDs
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+// Request from an administrator to search the audit log. Filters left empty match every event.
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//actorID denotes the user who made the calls.
+	ActorID int64 `protobuf:"varint,1,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	//targetUserID denotes the user whose health data the calls were for.
+	TargetUserID int64 `protobuf:"varint,2,opt,name=targetUserID,proto3" json:"targetUserID,omitempty"`
+	//method denotes the full name of the RPC that was called.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	//entryID denotes a log entry the calls read or changed.
+	EntryID string `protobuf:"bytes,4,opt,name=entryID,proto3" json:"entryID,omitempty"`
+	//outcome denotes the gRPC status code the calls finished with.
+	Outcome string `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	//startTime denotes the earliest time of the calls, inclusive.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	//endTime denotes the latest time of the calls, exclusive.
+	EndTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	//limit denotes the maximum number of events returned. A default limit is used when it is 0.
+	Limit uint32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *QueryAuditLogRequest) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetTargetUserID() int64 {
+	if x != nil {
+		return x.TargetUserID
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetEntryID() string {
+	if x != nil {
+		return x.EntryID
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//events denotes the matching audit events, most recent first.
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *QueryAuditLogResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PublishSurveyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//survey denotes the survey to publish. Its version, publishedBy and publishedAt are assigned by the server.
+	Survey *Survey `protobuf:"bytes,1,opt,name=survey,proto3" json:"survey,omitempty"`
+}
+
+func (x *PublishSurveyRequest) Reset() {
+	*x = PublishSurveyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSurveyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSurveyRequest) ProtoMessage() {}
+
+func (x *PublishSurveyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSurveyRequest.ProtoReflect.Descriptor instead.
+func (*PublishSurveyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PublishSurveyRequest) GetSurvey() *Survey {
+	if x != nil {
+		return x.Survey
+	}
+	return nil
+}
+
+type PublishSurveyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//survey denotes the published version.
+	Survey *Survey `protobuf:"bytes,1,opt,name=survey,proto3" json:"survey,omitempty"`
+}
+
+func (x *PublishSurveyResponse) Reset() {
+	*x = PublishSurveyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_health_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSurveyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSurveyResponse) ProtoMessage() {}
+
+func (x *PublishSurveyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_health_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSurveyResponse.ProtoReflect.Descriptor instead.
+func (*PublishSurveyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_health_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PublishSurveyResponse) GetSurvey() *Survey {
+	if x != nil {
+		return x.Survey
+	}
+	return nil
+}
+
+var File_proto_health_proto protoreflect.FileDescriptor
+
+var file_proto_health_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xbb,
+	0x03, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c,
+	0x6f, 0x67, 0x12, 0x2a, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x67,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x0e,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x5b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x60, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x5a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x96, 0x01,
+	0x0a, 0x1b, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x48, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x8c, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x03, 0x61,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12,
+	0x36, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x49, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
+	0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x69,
+	0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x3b, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x5a, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x23, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0x80, 0x02, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x33, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x81, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb6,
+	0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x6f, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2e, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x44, 0x61, 0x79, 0x4d, 0x65, 0x61, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x44, 0x61, 0x79,
+	0x4d, 0x65, 0x61, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x44, 0x61, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x65, 0x76,
+	0x65, 0x6e, 0x44, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x68,
+	0x69, 0x72, 0x74, 0x79, 0x44, 0x61, 0x79, 0x4d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x74, 0x68, 0x69, 0x72, 0x74, 0x79, 0x44, 0x61, 0x79, 0x4d, 0x65, 0x61, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68, 0x69, 0x72, 0x74, 0x79, 0x44, 0x61, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x68, 0x69, 0x72, 0x74, 0x79,
+	0x44, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x6f, 0x64, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d,
+	0x6f, 0x6f, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x12, 0x2e, 0x0a, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x6f,
+	0x6f, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79,
+	0x12, 0x30, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d,
+	0x6f, 0x6f, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x6c, 0x79, 0x12, 0x44, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66,
+	0x44, 0x61, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x4f, 0x66, 0x44, 0x61, 0x79, 0x22, 0x70, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44,
+	0x61, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x44, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44,
+	0x61, 0x79, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x4c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x22, 0xef, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x69,
+	0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0e, 0x64,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x36, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x49, 0x44, 0x73, 0x48, 0x00, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x20, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0xf6, 0x01, 0x0a,
+	0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x20,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x54,
+	0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x21,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x54,
+	0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0xb2, 0x01, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x65, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x47, 0x69, 0x76, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x47, 0x69, 0x76, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0e, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x15, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xd6, 0x01, 0x0a,
+	0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x0b, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x17, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3b, 0x0a,
+	0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x0a,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x76, 0x0a, 0x0e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x38,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x49, 0x44, 0x73, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x65, 0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xba, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x41, 0x73, 0x46, 0x48, 0x49, 0x52,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x41, 0x73, 0x46, 0x48, 0x49, 0x52, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xf8, 0x02, 0x0a,
+	0x0a, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x46, 0x6c, 0x61,
+	0x67, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b,
+	0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbf, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0xac, 0x01, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x22, 0x96,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x48, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xa8, 0x02, 0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x66, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x77, 0x49, 0x66, 0x22, 0x42, 0x0a, 0x12,
+	0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x22, 0xbc, 0x02, 0x0a, 0x06, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x76, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x37, 0x0a, 0x0b, 0x53, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x22, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x1b,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x1c, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x50, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0d,
+	0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x62, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x54, 0x61, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x4e,
+	0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x3b,
+	0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x3b, 0x0a, 0x11, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x61, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x41, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x39, 0x0a, 0x1d, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x44,
+	0x0a, 0x1e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x22, 0x34, 0x0a, 0x16, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x43, 0x0a, 0x17, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x2e, 0x0a, 0x14, 0x53, 0x68, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0xa7, 0x01, 0x0a, 0x15, 0x53, 0x68, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x16, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x0a, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x22, 0x43, 0x0a, 0x15, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x2a,
+	0x2a, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09,
+	0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
+	0x45, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x54, 0x45, 0x52, 0x4e, 0x4f,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x56, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x2a, 0x36, 0x0a, 0x0a,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x43,
+	0x4f, 0x52, 0x45, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x43, 0x4f, 0x52, 0x45, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4c, 0x53, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x14, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x24, 0x0a,
+	0x0e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x50, 0x48, 0x51, 0x39, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x41, 0x44,
+	0x37, 0x10, 0x01, 0x2a, 0x5c, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x4e,
+	0x49, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x49, 0x4c, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x56, 0x45, 0x52, 0x45, 0x10,
+	0x04, 0x2a, 0x3d, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x41, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02,
+	0x32, 0xb1, 0x19, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x14, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x41, 0x64, 0x64,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x46, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x2e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x12, 0x23,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44,
+	0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x54,
+	0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x64, 0x44,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x1c, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
+	0x12, 0x6d, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x41, 0x73, 0x46, 0x48, 0x49, 0x52, 0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x41, 0x73, 0x46, 0x48, 0x49, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x61, 0x74, 0x61, 0x41, 0x73, 0x46, 0x48, 0x49, 0x52, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x69,
+	0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdc, 0x03, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x6f, 0x0a, 0x16, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6b, 0x69, 0x63, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b,
+	0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x68, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x20, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e,
+	0x53, 0x68, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x2e, 0x53, 0x68, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x20, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x69, 0x63,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x20,
+	0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6b, 0x69, 0x63, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x16, 0x5a, 0x14, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_health_proto_rawDescOnce sync.Once
+	file_proto_health_proto_rawDescData = file_proto_health_proto_rawDesc
+)
+
+func file_proto_health_proto_rawDescGZIP() []byte {
+	file_proto_health_proto_rawDescOnce.Do(func() {
+		file_proto_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_health_proto_rawDescData)
+	})
+	return file_proto_health_proto_rawDescData
+}
+
+var file_proto_health_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_health_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
+var file_proto_health_proto_goTypes = []interface{}{
+	(SortOrder)(0),                              // 0: kic.health.SortOrder
+	(TimeOfDay)(0),                              // 1: kic.health.TimeOfDay
+	(GrantScope)(0),                             // 2: kic.health.GrantScope
+	(ExportFormat)(0),                           // 3: kic.health.ExportFormat
+	(ImportRowErrorReason)(0),                   // 4: kic.health.ImportRowErrorReason
+	(AssessmentType)(0),                         // 5: kic.health.AssessmentType
+	(AssessmentSeverity)(0),                     // 6: kic.health.AssessmentSeverity
+	(QuestionType)(0),                           // 7: kic.health.QuestionType
+	(*GetHealthDataForUserRequest)(nil),         // 8: kic.health.GetHealthDataForUserRequest
+	(*MentalHealthLog)(nil),                     // 9: kic.health.MentalHealthLog
+	(*DimensionScore)(nil),                      // 10: kic.health.DimensionScore
+	(*GetHealthDataForUserResponse)(nil),        // 11: kic.health.GetHealthDataForUserResponse
+	(*GetHealthDataByDateRequest)(nil),          // 12: kic.health.GetHealthDataByDateRequest
+	(*GetHealthDataByDateResponse)(nil),         // 13: kic.health.GetHealthDataByDateResponse
+	(*AddHealthDataForUserRequest)(nil),         // 14: kic.health.AddHealthDataForUserRequest
+	(*AddHealthDataForUserResponse)(nil),        // 15: kic.health.AddHealthDataForUserResponse
+	(*DeleteHealthDataForUserRequest)(nil),      // 16: kic.health.DeleteHealthDataForUserRequest
+	(*DeleteHealthDataForUserResponse)(nil),     // 17: kic.health.DeleteHealthDataForUserResponse
+	(*UpdateHealthDataForDateRequest)(nil),      // 18: kic.health.UpdateHealthDataForDateRequest
+	(*UpdateHealthDataForDateResponse)(nil),     // 19: kic.health.UpdateHealthDataForDateResponse
+	(*GetMentalHealthScoreForUserRequest)(nil),  // 20: kic.health.GetMentalHealthScoreForUserRequest
+	(*GetMentalHealthScoreForUserResponse)(nil), // 21: kic.health.GetMentalHealthScoreForUserResponse
+	(*GetHealthDataInRangeRequest)(nil),         // 22: kic.health.GetHealthDataInRangeRequest
+	(*GetHealthDataInRangeResponse)(nil),        // 23: kic.health.GetHealthDataInRangeResponse
+	(*GetMoodTrendsRequest)(nil),                // 24: kic.health.GetMoodTrendsRequest
+	(*MoodBucket)(nil),                          // 25: kic.health.MoodBucket
+	(*RollingAverage)(nil),                      // 26: kic.health.RollingAverage
+	(*GetMoodTrendsResponse)(nil),               // 27: kic.health.GetMoodTrendsResponse
+	(*TimeOfDayBucket)(nil),                     // 28: kic.health.TimeOfDayBucket
+	(*GetHealthLogByIDRequest)(nil),             // 29: kic.health.GetHealthLogByIDRequest
+	(*GetHealthLogByIDResponse)(nil),            // 30: kic.health.GetHealthLogByIDResponse
+	(*UpdateHealthLogByIDRequest)(nil),          // 31: kic.health.UpdateHealthLogByIDRequest
+	(*UpdateHealthLogByIDResponse)(nil),         // 32: kic.health.UpdateHealthLogByIDResponse
+	(*DeleteHealthLogByIDRequest)(nil),          // 33: kic.health.DeleteHealthLogByIDRequest
+	(*DeleteHealthLogByIDResponse)(nil),         // 34: kic.health.DeleteHealthLogByIDResponse
+	(*ListDeletedHealthLogsRequest)(nil),        // 35: kic.health.ListDeletedHealthLogsRequest
+	(*ListDeletedHealthLogsResponse)(nil),       // 36: kic.health.ListDeletedHealthLogsResponse
+	(*RestoreHealthLogsRequest)(nil),            // 37: kic.health.RestoreHealthLogsRequest
+	(*HealthLogIDs)(nil),                        // 38: kic.health.HealthLogIDs
+	(*RestoreHealthLogsResponse)(nil),           // 39: kic.health.RestoreHealthLogsResponse
+	(*HealthLogRevision)(nil),                   // 40: kic.health.HealthLogRevision
+	(*GetHealthLogRevisionsRequest)(nil),        // 41: kic.health.GetHealthLogRevisionsRequest
+	(*GetHealthLogRevisionsResponse)(nil),       // 42: kic.health.GetHealthLogRevisionsResponse
+	(*RevertHealthLogToRevisionRequest)(nil),    // 43: kic.health.RevertHealthLogToRevisionRequest
+	(*RevertHealthLogToRevisionResponse)(nil),   // 44: kic.health.RevertHealthLogToRevisionResponse
+	(*AccessGrant)(nil),                         // 45: kic.health.AccessGrant
+	(*GrantAccessRequest)(nil),                  // 46: kic.health.GrantAccessRequest
+	(*GrantAccessResponse)(nil),                 // 47: kic.health.GrantAccessResponse
+	(*RevokeAccessRequest)(nil),                 // 48: kic.health.RevokeAccessRequest
+	(*RevokeAccessResponse)(nil),                // 49: kic.health.RevokeAccessResponse
+	(*ListGrantsRequest)(nil),                   // 50: kic.health.ListGrantsRequest
+	(*ListGrantsResponse)(nil),                  // 51: kic.health.ListGrantsResponse
+	(*ExportUserDataRequest)(nil),               // 52: kic.health.ExportUserDataRequest
+	(*ExportManifest)(nil),                      // 53: kic.health.ExportManifest
+	(*ExportRecordType)(nil),                    // 54: kic.health.ExportRecordType
+	(*ExportChunk)(nil),                         // 55: kic.health.ExportChunk
+	(*ExportUserDataResponse)(nil),              // 56: kic.health.ExportUserDataResponse
+	(*ImportHealthDataRequest)(nil),             // 57: kic.health.ImportHealthDataRequest
+	(*ImportRowError)(nil),                      // 58: kic.health.ImportRowError
+	(*ImportHealthDataResponse)(nil),            // 59: kic.health.ImportHealthDataResponse
+	(*ImportExternalHealthDataRequest)(nil),     // 60: kic.health.ImportExternalHealthDataRequest
+	(*GetHealthDataAsFHIRRequest)(nil),          // 61: kic.health.GetHealthDataAsFHIRRequest
+	(*GetHealthDataAsFHIRResponse)(nil),         // 62: kic.health.GetHealthDataAsFHIRResponse
+	(*Assessment)(nil),                          // 63: kic.health.Assessment
+	(*SubmitAssessmentRequest)(nil),             // 64: kic.health.SubmitAssessmentRequest
+	(*SubmitAssessmentResponse)(nil),            // 65: kic.health.SubmitAssessmentResponse
+	(*ListAssessmentsRequest)(nil),              // 66: kic.health.ListAssessmentsRequest
+	(*ListAssessmentsResponse)(nil),             // 67: kic.health.ListAssessmentsResponse
+	(*GetAssessmentTrendRequest)(nil),           // 68: kic.health.GetAssessmentTrendRequest
+	(*AssessmentTrendPoint)(nil),                // 69: kic.health.AssessmentTrendPoint
+	(*GetAssessmentTrendResponse)(nil),          // 70: kic.health.GetAssessmentTrendResponse
+	(*SurveyChoice)(nil),                        // 71: kic.health.SurveyChoice
+	(*SurveyQuestion)(nil),                      // 72: kic.health.SurveyQuestion
+	(*SurveyScoreFormula)(nil),                  // 73: kic.health.SurveyScoreFormula
+	(*Survey)(nil),                              // 74: kic.health.Survey
+	(*SurveyAnswer)(nil),                        // 75: kic.health.SurveyAnswer
+	(*SurveyScore)(nil),                         // 76: kic.health.SurveyScore
+	(*SurveyResponse)(nil),                      // 77: kic.health.SurveyResponse
+	(*GetSurveyRequest)(nil),                    // 78: kic.health.GetSurveyRequest
+	(*GetSurveyResponse)(nil),                   // 79: kic.health.GetSurveyResponse
+	(*SubmitSurveyResponseRequest)(nil),         // 80: kic.health.SubmitSurveyResponseRequest
+	(*SubmitSurveyResponseResponse)(nil),        // 81: kic.health.SubmitSurveyResponseResponse
+	(*ListSurveyResponsesRequest)(nil),          // 82: kic.health.ListSurveyResponsesRequest
+	(*ListSurveyResponsesResponse)(nil),         // 83: kic.health.ListSurveyResponsesResponse
+	(*MoodDimension)(nil),                       // 84: kic.health.MoodDimension
+	(*ListMoodDimensionsRequest)(nil),           // 85: kic.health.ListMoodDimensionsRequest
+	(*ListMoodDimensionsResponse)(nil),          // 86: kic.health.ListMoodDimensionsResponse
+	(*TagUsage)(nil),                            // 87: kic.health.TagUsage
+	(*ListTagsRequest)(nil),                     // 88: kic.health.ListTagsRequest
+	(*ListTagsResponse)(nil),                    // 89: kic.health.ListTagsResponse
+	(*RenameTagRequest)(nil),                    // 90: kic.health.RenameTagRequest
+	(*RenameTagResponse)(nil),                   // 91: kic.health.RenameTagResponse
+	(*MergeTagsRequest)(nil),                    // 92: kic.health.MergeTagsRequest
+	(*MergeTagsResponse)(nil),                   // 93: kic.health.MergeTagsResponse
+	(*GetTagImpactRequest)(nil),                 // 94: kic.health.GetTagImpactRequest
+	(*TagImpact)(nil),                           // 95: kic.health.TagImpact
+	(*GetTagImpactResponse)(nil),                // 96: kic.health.GetTagImpactResponse
+	(*RebuildScoreAggregatesRequest)(nil),       // 97: kic.health.RebuildScoreAggregatesRequest
+	(*RebuildScoreAggregatesResponse)(nil),      // 98: kic.health.RebuildScoreAggregatesResponse
+	(*MigrateLogTimesRequest)(nil),              // 99: kic.health.MigrateLogTimesRequest
+	(*MigrateLogTimesResponse)(nil),             // 100: kic.health.MigrateLogTimesResponse
+	(*ShredUserDataRequest)(nil),                // 101: kic.health.ShredUserDataRequest
+	(*ShredUserDataResponse)(nil),               // 102: kic.health.ShredUserDataResponse
+	(*AuditEvent)(nil),                          // 103: kic.health.AuditEvent
+	(*QueryAuditLogRequest)(nil),                // 104: kic.health.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),               // 105: kic.health.QueryAuditLogResponse
+	(*PublishSurveyRequest)(nil),                // 106: kic.health.PublishSurveyRequest
+	(*PublishSurveyResponse)(nil),               // 107: kic.health.PublishSurveyResponse
+	(*common.Date)(nil),                         // 108: kic.common.Date
+	(*timestamp.Timestamp)(nil),                 // 109: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 110: google.protobuf.FieldMask
+}
+var file_proto_health_proto_depIdxs = []int32{
+	108, // 0: kic.health.MentalHealthLog.logDate:type_name -> kic.common.Date
+	109, // 1: kic.health.MentalHealthLog.deletedAt:type_name -> google.protobuf.Timestamp
+	10,  // 2: kic.health.MentalHealthLog.dimensionScores:type_name -> kic.health.DimensionScore
+	109, // 3: kic.health.MentalHealthLog.loggedAt:type_name -> google.protobuf.Timestamp
+	9,   // 4: kic.health.GetHealthDataForUserResponse.healthData:type_name -> kic.health.MentalHealthLog
+	108, // 5: kic.health.GetHealthDataByDateRequest.logDate:type_name -> kic.common.Date
+	9,   // 6: kic.health.GetHealthDataByDateResponse.healthData:type_name -> kic.health.MentalHealthLog
+	9,   // 7: kic.health.AddHealthDataForUserRequest.newEntry:type_name -> kic.health.MentalHealthLog
+	108, // 8: kic.health.DeleteHealthDataForUserRequest.dateToRemove:type_name -> kic.common.Date
+	9,   // 9: kic.health.UpdateHealthDataForDateRequest.desiredLogInfo:type_name -> kic.health.MentalHealthLog
+	110, // 10: kic.health.UpdateHealthDataForDateRequest.updateMask:type_name -> google.protobuf.FieldMask
+	108, // 11: kic.health.GetHealthDataInRangeRequest.startDate:type_name -> kic.common.Date
+	108, // 12: kic.health.GetHealthDataInRangeRequest.endDate:type_name -> kic.common.Date
+	0,   // 13: kic.health.GetHealthDataInRangeRequest.sortOrder:type_name -> kic.health.SortOrder
+	9,   // 14: kic.health.GetHealthDataInRangeResponse.healthData:type_name -> kic.health.MentalHealthLog
+	108, // 15: kic.health.GetMoodTrendsRequest.startDate:type_name -> kic.common.Date
+	108, // 16: kic.health.GetMoodTrendsRequest.endDate:type_name -> kic.common.Date
+	108, // 17: kic.health.MoodBucket.startDate:type_name -> kic.common.Date
+	108, // 18: kic.health.MoodBucket.endDate:type_name -> kic.common.Date
+	108, // 19: kic.health.RollingAverage.date:type_name -> kic.common.Date
+	25,  // 20: kic.health.GetMoodTrendsResponse.daily:type_name -> kic.health.MoodBucket
+	25,  // 21: kic.health.GetMoodTrendsResponse.weekly:type_name -> kic.health.MoodBucket
+	25,  // 22: kic.health.GetMoodTrendsResponse.monthly:type_name -> kic.health.MoodBucket
+	26,  // 23: kic.health.GetMoodTrendsResponse.rollingAverages:type_name -> kic.health.RollingAverage
+	28,  // 24: kic.health.GetMoodTrendsResponse.timesOfDay:type_name -> kic.health.TimeOfDayBucket
+	1,   // 25: kic.health.TimeOfDayBucket.timeOfDay:type_name -> kic.health.TimeOfDay
+	9,   // 26: kic.health.GetHealthLogByIDResponse.healthLog:type_name -> kic.health.MentalHealthLog
+	9,   // 27: kic.health.UpdateHealthLogByIDRequest.desiredLogInfo:type_name -> kic.health.MentalHealthLog
+	110, // 28: kic.health.UpdateHealthLogByIDRequest.updateMask:type_name -> google.protobuf.FieldMask
+	9,   // 29: kic.health.ListDeletedHealthLogsResponse.healthData:type_name -> kic.health.MentalHealthLog
+	38,  // 30: kic.health.RestoreHealthLogsRequest.ids:type_name -> kic.health.HealthLogIDs
+	109, // 31: kic.health.HealthLogRevision.revisedAt:type_name -> google.protobuf.Timestamp
+	9,   // 32: kic.health.HealthLogRevision.healthLog:type_name -> kic.health.MentalHealthLog
+	40,  // 33: kic.health.GetHealthLogRevisionsResponse.revisions:type_name -> kic.health.HealthLogRevision
+	2,   // 34: kic.health.AccessGrant.scope:type_name -> kic.health.GrantScope
+	109, // 35: kic.health.AccessGrant.createdAt:type_name -> google.protobuf.Timestamp
+	109, // 36: kic.health.AccessGrant.expiresAt:type_name -> google.protobuf.Timestamp
+	2,   // 37: kic.health.GrantAccessRequest.scope:type_name -> kic.health.GrantScope
+	109, // 38: kic.health.GrantAccessRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	45,  // 39: kic.health.GrantAccessResponse.grant:type_name -> kic.health.AccessGrant
+	45,  // 40: kic.health.ListGrantsResponse.grantsGiven:type_name -> kic.health.AccessGrant
+	45,  // 41: kic.health.ListGrantsResponse.grantsReceived:type_name -> kic.health.AccessGrant
+	3,   // 42: kic.health.ExportUserDataRequest.format:type_name -> kic.health.ExportFormat
+	3,   // 43: kic.health.ExportManifest.format:type_name -> kic.health.ExportFormat
+	109, // 44: kic.health.ExportManifest.exportedAt:type_name -> google.protobuf.Timestamp
+	54,  // 45: kic.health.ExportManifest.recordTypes:type_name -> kic.health.ExportRecordType
+	53,  // 46: kic.health.ExportUserDataResponse.manifest:type_name -> kic.health.ExportManifest
+	55,  // 47: kic.health.ExportUserDataResponse.chunk:type_name -> kic.health.ExportChunk
+	9,   // 48: kic.health.ImportHealthDataRequest.healthData:type_name -> kic.health.MentalHealthLog
+	4,   // 49: kic.health.ImportRowError.reason:type_name -> kic.health.ImportRowErrorReason
+	38,  // 50: kic.health.ImportHealthDataResponse.ids:type_name -> kic.health.HealthLogIDs
+	58,  // 51: kic.health.ImportHealthDataResponse.errors:type_name -> kic.health.ImportRowError
+	108, // 52: kic.health.GetHealthDataAsFHIRRequest.startDate:type_name -> kic.common.Date
+	108, // 53: kic.health.GetHealthDataAsFHIRRequest.endDate:type_name -> kic.common.Date
+	5,   // 54: kic.health.Assessment.type:type_name -> kic.health.AssessmentType
+	108, // 55: kic.health.Assessment.assessmentDate:type_name -> kic.common.Date
+	109, // 56: kic.health.Assessment.submittedAt:type_name -> google.protobuf.Timestamp
+	6,   // 57: kic.health.Assessment.severity:type_name -> kic.health.AssessmentSeverity
+	5,   // 58: kic.health.SubmitAssessmentRequest.type:type_name -> kic.health.AssessmentType
+	108, // 59: kic.health.SubmitAssessmentRequest.assessmentDate:type_name -> kic.common.Date
+	63,  // 60: kic.health.SubmitAssessmentResponse.assessment:type_name -> kic.health.Assessment
+	5,   // 61: kic.health.ListAssessmentsRequest.types:type_name -> kic.health.AssessmentType
+	108, // 62: kic.health.ListAssessmentsRequest.startDate:type_name -> kic.common.Date
+	108, // 63: kic.health.ListAssessmentsRequest.endDate:type_name -> kic.common.Date
+	63,  // 64: kic.health.ListAssessmentsResponse.assessments:type_name -> kic.health.Assessment
+	5,   // 65: kic.health.GetAssessmentTrendRequest.type:type_name -> kic.health.AssessmentType
+	108, // 66: kic.health.GetAssessmentTrendRequest.startDate:type_name -> kic.common.Date
+	108, // 67: kic.health.GetAssessmentTrendRequest.endDate:type_name -> kic.common.Date
+	108, // 68: kic.health.AssessmentTrendPoint.assessmentDate:type_name -> kic.common.Date
+	6,   // 69: kic.health.AssessmentTrendPoint.severity:type_name -> kic.health.AssessmentSeverity
+	69,  // 70: kic.health.GetAssessmentTrendResponse.points:type_name -> kic.health.AssessmentTrendPoint
+	7,   // 71: kic.health.SurveyQuestion.type:type_name -> kic.health.QuestionType
+	71,  // 72: kic.health.SurveyQuestion.choices:type_name -> kic.health.SurveyChoice
+	72,  // 73: kic.health.Survey.questions:type_name -> kic.health.SurveyQuestion
+	73,  // 74: kic.health.Survey.scores:type_name -> kic.health.SurveyScoreFormula
+	109, // 75: kic.health.Survey.publishedAt:type_name -> google.protobuf.Timestamp
+	109, // 76: kic.health.SurveyResponse.submittedAt:type_name -> google.protobuf.Timestamp
+	75,  // 77: kic.health.SurveyResponse.answers:type_name -> kic.health.SurveyAnswer
+	76,  // 78: kic.health.SurveyResponse.scores:type_name -> kic.health.SurveyScore
+	74,  // 79: kic.health.GetSurveyResponse.survey:type_name -> kic.health.Survey
+	75,  // 80: kic.health.SubmitSurveyResponseRequest.answers:type_name -> kic.health.SurveyAnswer
+	77,  // 81: kic.health.SubmitSurveyResponseResponse.response:type_name -> kic.health.SurveyResponse
+	77,  // 82: kic.health.ListSurveyResponsesResponse.responses:type_name -> kic.health.SurveyResponse
+	84,  // 83: kic.health.ListMoodDimensionsResponse.dimensions:type_name -> kic.health.MoodDimension
+	108, // 84: kic.health.TagUsage.lastUsed:type_name -> kic.common.Date
+	87,  // 85: kic.health.ListTagsResponse.tags:type_name -> kic.health.TagUsage
+	108, // 86: kic.health.GetTagImpactRequest.startDate:type_name -> kic.common.Date
+	108, // 87: kic.health.GetTagImpactRequest.endDate:type_name -> kic.common.Date
+	95,  // 88: kic.health.GetTagImpactResponse.tags:type_name -> kic.health.TagImpact
+	109, // 89: kic.health.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	109, // 90: kic.health.QueryAuditLogRequest.startTime:type_name -> google.protobuf.Timestamp
+	109, // 91: kic.health.QueryAuditLogRequest.endTime:type_name -> google.protobuf.Timestamp
+	103, // 92: kic.health.QueryAuditLogResponse.events:type_name -> kic.health.AuditEvent
+	74,  // 93: kic.health.PublishSurveyRequest.survey:type_name -> kic.health.Survey
+	74,  // 94: kic.health.PublishSurveyResponse.survey:type_name -> kic.health.Survey
+	8,   // 95: kic.health.HealthTracking.GetHealthDataForUser:input_type -> kic.health.GetHealthDataForUserRequest
+	14,  // 96: kic.health.HealthTracking.AddHealthDataForUser:input_type -> kic.health.AddHealthDataForUserRequest
+	16,  // 97: kic.health.HealthTracking.DeleteHealthDataForUser:input_type -> kic.health.DeleteHealthDataForUserRequest
+	18,  // 98: kic.health.HealthTracking.UpdateHealthDataForDate:input_type -> kic.health.UpdateHealthDataForDateRequest
+	20,  // 99: kic.health.HealthTracking.GetMentalHealthScoreForUser:input_type -> kic.health.GetMentalHealthScoreForUserRequest
+	12,  // 100: kic.health.HealthTracking.GetHealthDataByDate:input_type -> kic.health.GetHealthDataByDateRequest
+	22,  // 101: kic.health.HealthTracking.GetHealthDataInRange:input_type -> kic.health.GetHealthDataInRangeRequest
+	24,  // 102: kic.health.HealthTracking.GetMoodTrends:input_type -> kic.health.GetMoodTrendsRequest
+	29,  // 103: kic.health.HealthTracking.GetHealthLogByID:input_type -> kic.health.GetHealthLogByIDRequest
+	31,  // 104: kic.health.HealthTracking.UpdateHealthLogByID:input_type -> kic.health.UpdateHealthLogByIDRequest
+	33,  // 105: kic.health.HealthTracking.DeleteHealthLogByID:input_type -> kic.health.DeleteHealthLogByIDRequest
+	35,  // 106: kic.health.HealthTracking.ListDeletedHealthLogs:input_type -> kic.health.ListDeletedHealthLogsRequest
+	37,  // 107: kic.health.HealthTracking.RestoreHealthLogs:input_type -> kic.health.RestoreHealthLogsRequest
+	41,  // 108: kic.health.HealthTracking.GetHealthLogRevisions:input_type -> kic.health.GetHealthLogRevisionsRequest
+	43,  // 109: kic.health.HealthTracking.RevertHealthLogToRevision:input_type -> kic.health.RevertHealthLogToRevisionRequest
+	46,  // 110: kic.health.HealthTracking.GrantAccess:input_type -> kic.health.GrantAccessRequest
+	48,  // 111: kic.health.HealthTracking.RevokeAccess:input_type -> kic.health.RevokeAccessRequest
+	50,  // 112: kic.health.HealthTracking.ListGrants:input_type -> kic.health.ListGrantsRequest
+	52,  // 113: kic.health.HealthTracking.ExportUserData:input_type -> kic.health.ExportUserDataRequest
+	85,  // 114: kic.health.HealthTracking.ListMoodDimensions:input_type -> kic.health.ListMoodDimensionsRequest
+	88,  // 115: kic.health.HealthTracking.ListTags:input_type -> kic.health.ListTagsRequest
+	90,  // 116: kic.health.HealthTracking.RenameTag:input_type -> kic.health.RenameTagRequest
+	92,  // 117: kic.health.HealthTracking.MergeTags:input_type -> kic.health.MergeTagsRequest
+	94,  // 118: kic.health.HealthTracking.GetTagImpact:input_type -> kic.health.GetTagImpactRequest
+	57,  // 119: kic.health.HealthTracking.ImportHealthData:input_type -> kic.health.ImportHealthDataRequest
+	60,  // 120: kic.health.HealthTracking.ImportExternalHealthData:input_type -> kic.health.ImportExternalHealthDataRequest
+	61,  // 121: kic.health.HealthTracking.GetHealthDataAsFHIR:input_type -> kic.health.GetHealthDataAsFHIRRequest
+	64,  // 122: kic.health.HealthTracking.SubmitAssessment:input_type -> kic.health.SubmitAssessmentRequest
+	66,  // 123: kic.health.HealthTracking.ListAssessments:input_type -> kic.health.ListAssessmentsRequest
+	68,  // 124: kic.health.HealthTracking.GetAssessmentTrend:input_type -> kic.health.GetAssessmentTrendRequest
+	78,  // 125: kic.health.HealthTracking.GetSurvey:input_type -> kic.health.GetSurveyRequest
+	80,  // 126: kic.health.HealthTracking.SubmitSurveyResponse:input_type -> kic.health.SubmitSurveyResponseRequest
+	82,  // 127: kic.health.HealthTracking.ListSurveyResponses:input_type -> kic.health.ListSurveyResponsesRequest
+	97,  // 128: kic.health.HealthAdmin.RebuildScoreAggregates:input_type -> kic.health.RebuildScoreAggregatesRequest
+	99,  // 129: kic.health.HealthAdmin.MigrateLogTimes:input_type -> kic.health.MigrateLogTimesRequest
+	101, // 130: kic.health.HealthAdmin.ShredUserData:input_type -> kic.health.ShredUserDataRequest
+	104, // 131: kic.health.HealthAdmin.QueryAuditLog:input_type -> kic.health.QueryAuditLogRequest
+	106, // 132: kic.health.HealthAdmin.PublishSurvey:input_type -> kic.health.PublishSurveyRequest
+	11,  // 133: kic.health.HealthTracking.GetHealthDataForUser:output_type -> kic.health.GetHealthDataForUserResponse
+	15,  // 134: kic.health.HealthTracking.AddHealthDataForUser:output_type -> kic.health.AddHealthDataForUserResponse
+	17,  // 135: kic.health.HealthTracking.DeleteHealthDataForUser:output_type -> kic.health.DeleteHealthDataForUserResponse
+	19,  // 136: kic.health.HealthTracking.UpdateHealthDataForDate:output_type -> kic.health.UpdateHealthDataForDateResponse
+	21,  // 137: kic.health.HealthTracking.GetMentalHealthScoreForUser:output_type -> kic.health.GetMentalHealthScoreForUserResponse
+	13,  // 138: kic.health.HealthTracking.GetHealthDataByDate:output_type -> kic.health.GetHealthDataByDateResponse
+	23,  // 139: kic.health.HealthTracking.GetHealthDataInRange:output_type -> kic.health.GetHealthDataInRangeResponse
+	27,  // 140: kic.health.HealthTracking.GetMoodTrends:output_type -> kic.health.GetMoodTrendsResponse
+	30,  // 141: kic.health.HealthTracking.GetHealthLogByID:output_type -> kic.health.GetHealthLogByIDResponse
+	32,  // 142: kic.health.HealthTracking.UpdateHealthLogByID:output_type -> kic.health.UpdateHealthLogByIDResponse
+	34,  // 143: kic.health.HealthTracking.DeleteHealthLogByID:output_type -> kic.health.DeleteHealthLogByIDResponse
+	36,  // 144: kic.health.HealthTracking.ListDeletedHealthLogs:output_type -> kic.health.ListDeletedHealthLogsResponse
+	39,  // 145: kic.health.HealthTracking.RestoreHealthLogs:output_type -> kic.health.RestoreHealthLogsResponse
+	42,  // 146: kic.health.HealthTracking.GetHealthLogRevisions:output_type -> kic.health.GetHealthLogRevisionsResponse
+	44,  // 147: kic.health.HealthTracking.RevertHealthLogToRevision:output_type -> kic.health.RevertHealthLogToRevisionResponse
+	47,  // 148: kic.health.HealthTracking.GrantAccess:output_type -> kic.health.GrantAccessResponse
+	49,  // 149: kic.health.HealthTracking.RevokeAccess:output_type -> kic.health.RevokeAccessResponse
+	51,  // 150: kic.health.HealthTracking.ListGrants:output_type -> kic.health.ListGrantsResponse
+	56,  // 151: kic.health.HealthTracking.ExportUserData:output_type -> kic.health.ExportUserDataResponse
+	86,  // 152: kic.health.HealthTracking.ListMoodDimensions:output_type -> kic.health.ListMoodDimensionsResponse
+	89,  // 153: kic.health.HealthTracking.ListTags:output_type -> kic.health.ListTagsResponse
+	91,  // 154: kic.health.HealthTracking.RenameTag:output_type -> kic.health.RenameTagResponse
+	93,  // 155: kic.health.HealthTracking.MergeTags:output_type -> kic.health.MergeTagsResponse
+	96,  // 156: kic.health.HealthTracking.GetTagImpact:output_type -> kic.health.GetTagImpactResponse
+	59,  // 157: kic.health.HealthTracking.ImportHealthData:output_type -> kic.health.ImportHealthDataResponse
+	59,  // 158: kic.health.HealthTracking.ImportExternalHealthData:output_type -> kic.health.ImportHealthDataResponse
+	62,  // 159: kic.health.HealthTracking.GetHealthDataAsFHIR:output_type -> kic.health.GetHealthDataAsFHIRResponse
+	65,  // 160: kic.health.HealthTracking.SubmitAssessment:output_type -> kic.health.SubmitAssessmentResponse
+	67,  // 161: kic.health.HealthTracking.ListAssessments:output_type -> kic.health.ListAssessmentsResponse
+	70,  // 162: kic.health.HealthTracking.GetAssessmentTrend:output_type -> kic.health.GetAssessmentTrendResponse
+	79,  // 163: kic.health.HealthTracking.GetSurvey:output_type -> kic.health.GetSurveyResponse
+	81,  // 164: kic.health.HealthTracking.SubmitSurveyResponse:output_type -> kic.health.SubmitSurveyResponseResponse
+	83,  // 165: kic.health.HealthTracking.ListSurveyResponses:output_type -> kic.health.ListSurveyResponsesResponse
+	98,  // 166: kic.health.HealthAdmin.RebuildScoreAggregates:output_type -> kic.health.RebuildScoreAggregatesResponse
+	100, // 167: kic.health.HealthAdmin.MigrateLogTimes:output_type -> kic.health.MigrateLogTimesResponse
+	102, // 168: kic.health.HealthAdmin.ShredUserData:output_type -> kic.health.ShredUserDataResponse
+	105, // 169: kic.health.HealthAdmin.QueryAuditLog:output_type -> kic.health.QueryAuditLogResponse
+	107, // 170: kic.health.HealthAdmin.PublishSurvey:output_type -> kic.health.PublishSurveyResponse
+	133, // [133:171] is the sub-list for method output_type
+	95,  // [95:133] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
+}
+
+func init() { file_proto_health_proto_init() }
+func file_proto_health_proto_init() {
+	if File_proto_health_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_health_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataForUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MentalHealthLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DimensionScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataByDateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataByDateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddHealthDataForUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddHealthDataForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHealthDataForUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHealthDataForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthDataForDateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthDataForDateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMentalHealthScoreForUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMentalHealthScoreForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataInRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataInRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMoodTrendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoodBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollingAverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMoodTrendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeOfDayBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthLogByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthLogByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthLogByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthLogByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHealthLogByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHealthLogByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedHealthLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedHealthLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreHealthLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthLogIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreHealthLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthLogRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthLogRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthLogRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertHealthLogToRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertHealthLogToRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRecordType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportHealthDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportHealthDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExternalHealthDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataAsFHIRRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthDataAsFHIRResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assessment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAssessmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAssessmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssessmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssessmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssessmentTrendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessmentTrendPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssessmentTrendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyChoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyScoreFormula); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Survey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthDataByDateResponse); i {
+		file_proto_health_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1018,8 +9146,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddHealthDataForUserRequest); i {
+		file_proto_health_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1030,8 +9158,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddHealthDataForUserResponse); i {
+		file_proto_health_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSurveyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1042,8 +9170,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteHealthDataForUserRequest); i {
+		file_proto_health_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSurveyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1054,8 +9182,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteHealthDataForUserResponse); i {
+		file_proto_health_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSurveyResponseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1066,8 +9194,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHealthDataForDateRequest); i {
+		file_proto_health_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSurveyResponseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,8 +9206,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHealthDataForDateResponse); i {
+		file_proto_health_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSurveyResponsesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,8 +9218,8 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMentalHealthScoreForUserRequest); i {
+		file_proto_health_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSurveyResponsesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1102,8 +9230,284 @@ func file_proto_health_proto_init() {
 				return nil
 			}
 		}
-		file_proto_health_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMentalHealthScoreForUserResponse); i {
+		file_proto_health_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoodDimension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMoodDimensionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMoodDimensionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagImpactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagImpactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebuildScoreAggregatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebuildScoreAggregatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateLogTimesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateLogTimesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShredUserDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShredUserDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSurveyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_health_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSurveyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1115,22 +9519,31 @@ func file_proto_health_proto_init() {
 			}
 		}
 	}
-	file_proto_health_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_proto_health_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*DeleteHealthDataForUserRequest_All)(nil),
 		(*DeleteHealthDataForUserRequest_DateToRemove)(nil),
 	}
+	file_proto_health_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*RestoreHealthLogsRequest_All)(nil),
+		(*RestoreHealthLogsRequest_Ids)(nil),
+	}
+	file_proto_health_proto_msgTypes[48].OneofWrappers = []interface{}{
+		(*ExportUserDataResponse_Manifest)(nil),
+		(*ExportUserDataResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_health_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      8,
+			NumMessages:   100,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_health_proto_goTypes,
 		DependencyIndexes: file_proto_health_proto_depIdxs,
+		EnumInfos:         file_proto_health_proto_enumTypes,
 		MessageInfos:      file_proto_health_proto_msgTypes,
 	}.Build()
 	File_proto_health_proto = out.File
@@ -22,6 +22,7 @@ type HealthTrackingClient interface {
 	//Health data requested to be added by user is added, and error is returned if appropriate.
 	AddHealthDataForUser(ctx context.Context, in *AddHealthDataForUserRequest, opts ...grpc.CallOption) (*AddHealthDataForUserResponse, error)
 	//Health data requested by user to be deleted is deleted and said deleted entries are returned to user.
+	//Deleted entries are kept in the trash until they are purged.
 	DeleteHealthDataForUser(ctx context.Context, in *DeleteHealthDataForUserRequest, opts ...grpc.CallOption) (*DeleteHealthDataForUserResponse, error)
 	//Health data requested to be updated by user is updated, and error is returned if appropriate.
 	UpdateHealthDataForDate(ctx context.Context, in *UpdateHealthDataForDateRequest, opts ...grpc.CallOption) (*UpdateHealthDataForDateResponse, error)
@@ -29,6 +30,61 @@ type HealthTrackingClient interface {
 	GetMentalHealthScoreForUser(ctx context.Context, in *GetMentalHealthScoreForUserRequest, opts ...grpc.CallOption) (*GetMentalHealthScoreForUserResponse, error)
 	// Given a date and user ID, return health data log for a specific date
 	GetHealthDataByDate(ctx context.Context, in *GetHealthDataByDateRequest, opts ...grpc.CallOption) (*GetHealthDataByDateResponse, error)
+	// Given a date range and user ID, return one page of health data logs within the range
+	GetHealthDataInRange(ctx context.Context, in *GetHealthDataInRangeRequest, opts ...grpc.CallOption) (*GetHealthDataInRangeResponse, error)
+	// Given a date range and user ID, return daily, weekly and monthly score statistics and rolling averages
+	GetMoodTrends(ctx context.Context, in *GetMoodTrendsRequest, opts ...grpc.CallOption) (*GetMoodTrendsResponse, error)
+	// Given a log entry ID and user ID, return that log entry
+	GetHealthLogByID(ctx context.Context, in *GetHealthLogByIDRequest, opts ...grpc.CallOption) (*GetHealthLogByIDResponse, error)
+	// Given a log entry ID and user ID, update that log entry only
+	UpdateHealthLogByID(ctx context.Context, in *UpdateHealthLogByIDRequest, opts ...grpc.CallOption) (*UpdateHealthLogByIDResponse, error)
+	// Given a log entry ID and user ID, delete that log entry only
+	DeleteHealthLogByID(ctx context.Context, in *DeleteHealthLogByIDRequest, opts ...grpc.CallOption) (*DeleteHealthLogByIDResponse, error)
+	// Given user ID, return the deleted log entries that can still be restored
+	ListDeletedHealthLogs(ctx context.Context, in *ListDeletedHealthLogsRequest, opts ...grpc.CallOption) (*ListDeletedHealthLogsResponse, error)
+	// Given log entry IDs and user ID, move those entries out of the trash
+	RestoreHealthLogs(ctx context.Context, in *RestoreHealthLogsRequest, opts ...grpc.CallOption) (*RestoreHealthLogsResponse, error)
+	// Given a log entry ID and user ID, return how that entry looked before each of its updates
+	GetHealthLogRevisions(ctx context.Context, in *GetHealthLogRevisionsRequest, opts ...grpc.CallOption) (*GetHealthLogRevisionsResponse, error)
+	// Given a log entry ID, revision and user ID, set that entry back to the revision, keeping its current state as a new revision
+	RevertHealthLogToRevision(ctx context.Context, in *RevertHealthLogToRevisionRequest, opts ...grpc.CallOption) (*RevertHealthLogToRevisionResponse, error)
+	// Given user ID, share their health data with another user until the grant expires
+	GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*GrantAccessResponse, error)
+	// Given user ID, stop sharing their health data with another user
+	RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error)
+	// Given user ID, return the access grants they have given and received
+	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
+	// Given user ID, stream a copy of all of their health data, starting with a manifest of what it holds
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (HealthTracking_ExportUserDataClient, error)
+	// Return the mood dimensions logs can rate, with their ranges
+	ListMoodDimensions(ctx context.Context, in *ListMoodDimensionsRequest, opts ...grpc.CallOption) (*ListMoodDimensionsResponse, error)
+	// Given user ID, return the tags on their logs with how often each is used
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Given user ID, rename a tag on all of their logs
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// Given user ID, replace several tags with one on all of their logs
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// Given a date range and user ID, return the average score on days with and without each tag
+	GetTagImpact(ctx context.Context, in *GetTagImpactRequest, opts ...grpc.CallOption) (*GetTagImpactResponse, error)
+	// Given user ID, add batches of historical health data, skipping entries for dates that already have one
+	ImportHealthData(ctx context.Context, opts ...grpc.CallOption) (HealthTracking_ImportHealthDataClient, error)
+	// Given user ID, add the health data of a file exported from another mood tracking app, skipping entries for dates
+	// that already have one
+	ImportExternalHealthData(ctx context.Context, in *ImportExternalHealthDataRequest, opts ...grpc.CallOption) (*ImportHealthDataResponse, error)
+	// Given user ID, return their health data as FHIR Observations, for use by healthcare providers
+	GetHealthDataAsFHIR(ctx context.Context, in *GetHealthDataAsFHIRRequest, opts ...grpc.CallOption) (*GetHealthDataAsFHIRResponse, error)
+	// Given user ID, score and store their answers to a PHQ-9 or GAD-7 questionnaire
+	SubmitAssessment(ctx context.Context, in *SubmitAssessmentRequest, opts ...grpc.CallOption) (*SubmitAssessmentResponse, error)
+	// Given user ID, return their assessments
+	ListAssessments(ctx context.Context, in *ListAssessmentsRequest, opts ...grpc.CallOption) (*ListAssessmentsResponse, error)
+	// Given user ID, return how the total score of one questionnaire changed over time
+	GetAssessmentTrend(ctx context.Context, in *GetAssessmentTrendRequest, opts ...grpc.CallOption) (*GetAssessmentTrendResponse, error)
+	// Return a published survey
+	GetSurvey(ctx context.Context, in *GetSurveyRequest, opts ...grpc.CallOption) (*GetSurveyResponse, error)
+	// Given user ID, check their answers to a survey against its branching, score them and store them
+	SubmitSurveyResponse(ctx context.Context, in *SubmitSurveyResponseRequest, opts ...grpc.CallOption) (*SubmitSurveyResponseResponse, error)
+	// Given user ID, return their survey responses
+	ListSurveyResponses(ctx context.Context, in *ListSurveyResponsesRequest, opts ...grpc.CallOption) (*ListSurveyResponsesResponse, error)
 }
 
 type healthTrackingClient struct {
@@ -93,6 +149,297 @@ func (c *healthTrackingClient) GetHealthDataByDate(ctx context.Context, in *GetH
 	return out, nil
 }
 
+func (c *healthTrackingClient) GetHealthDataInRange(ctx context.Context, in *GetHealthDataInRangeRequest, opts ...grpc.CallOption) (*GetHealthDataInRangeResponse, error) {
+	out := new(GetHealthDataInRangeResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetHealthDataInRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetMoodTrends(ctx context.Context, in *GetMoodTrendsRequest, opts ...grpc.CallOption) (*GetMoodTrendsResponse, error) {
+	out := new(GetMoodTrendsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetMoodTrends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetHealthLogByID(ctx context.Context, in *GetHealthLogByIDRequest, opts ...grpc.CallOption) (*GetHealthLogByIDResponse, error) {
+	out := new(GetHealthLogByIDResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetHealthLogByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) UpdateHealthLogByID(ctx context.Context, in *UpdateHealthLogByIDRequest, opts ...grpc.CallOption) (*UpdateHealthLogByIDResponse, error) {
+	out := new(UpdateHealthLogByIDResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/UpdateHealthLogByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) DeleteHealthLogByID(ctx context.Context, in *DeleteHealthLogByIDRequest, opts ...grpc.CallOption) (*DeleteHealthLogByIDResponse, error) {
+	out := new(DeleteHealthLogByIDResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/DeleteHealthLogByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ListDeletedHealthLogs(ctx context.Context, in *ListDeletedHealthLogsRequest, opts ...grpc.CallOption) (*ListDeletedHealthLogsResponse, error) {
+	out := new(ListDeletedHealthLogsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListDeletedHealthLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) RestoreHealthLogs(ctx context.Context, in *RestoreHealthLogsRequest, opts ...grpc.CallOption) (*RestoreHealthLogsResponse, error) {
+	out := new(RestoreHealthLogsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/RestoreHealthLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetHealthLogRevisions(ctx context.Context, in *GetHealthLogRevisionsRequest, opts ...grpc.CallOption) (*GetHealthLogRevisionsResponse, error) {
+	out := new(GetHealthLogRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetHealthLogRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) RevertHealthLogToRevision(ctx context.Context, in *RevertHealthLogToRevisionRequest, opts ...grpc.CallOption) (*RevertHealthLogToRevisionResponse, error) {
+	out := new(RevertHealthLogToRevisionResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/RevertHealthLogToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*GrantAccessResponse, error) {
+	out := new(GrantAccessResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GrantAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error) {
+	out := new(RevokeAccessResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/RevokeAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error) {
+	out := new(ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (HealthTracking_ExportUserDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HealthTracking_serviceDesc.Streams[0], "/kic.health.HealthTracking/ExportUserData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthTrackingExportUserDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HealthTracking_ExportUserDataClient interface {
+	Recv() (*ExportUserDataResponse, error)
+	grpc.ClientStream
+}
+
+type healthTrackingExportUserDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthTrackingExportUserDataClient) Recv() (*ExportUserDataResponse, error) {
+	m := new(ExportUserDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *healthTrackingClient) ListMoodDimensions(ctx context.Context, in *ListMoodDimensionsRequest, opts ...grpc.CallOption) (*ListMoodDimensionsResponse, error) {
+	out := new(ListMoodDimensionsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListMoodDimensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetTagImpact(ctx context.Context, in *GetTagImpactRequest, opts ...grpc.CallOption) (*GetTagImpactResponse, error) {
+	out := new(GetTagImpactResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetTagImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ImportHealthData(ctx context.Context, opts ...grpc.CallOption) (HealthTracking_ImportHealthDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HealthTracking_serviceDesc.Streams[1], "/kic.health.HealthTracking/ImportHealthData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthTrackingImportHealthDataClient{stream}
+	return x, nil
+}
+
+type HealthTracking_ImportHealthDataClient interface {
+	Send(*ImportHealthDataRequest) error
+	CloseAndRecv() (*ImportHealthDataResponse, error)
+	grpc.ClientStream
+}
+
+type healthTrackingImportHealthDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthTrackingImportHealthDataClient) Send(m *ImportHealthDataRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *healthTrackingImportHealthDataClient) CloseAndRecv() (*ImportHealthDataResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportHealthDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *healthTrackingClient) ImportExternalHealthData(ctx context.Context, in *ImportExternalHealthDataRequest, opts ...grpc.CallOption) (*ImportHealthDataResponse, error) {
+	out := new(ImportHealthDataResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ImportExternalHealthData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetHealthDataAsFHIR(ctx context.Context, in *GetHealthDataAsFHIRRequest, opts ...grpc.CallOption) (*GetHealthDataAsFHIRResponse, error) {
+	out := new(GetHealthDataAsFHIRResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetHealthDataAsFHIR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) SubmitAssessment(ctx context.Context, in *SubmitAssessmentRequest, opts ...grpc.CallOption) (*SubmitAssessmentResponse, error) {
+	out := new(SubmitAssessmentResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/SubmitAssessment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ListAssessments(ctx context.Context, in *ListAssessmentsRequest, opts ...grpc.CallOption) (*ListAssessmentsResponse, error) {
+	out := new(ListAssessmentsResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListAssessments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetAssessmentTrend(ctx context.Context, in *GetAssessmentTrendRequest, opts ...grpc.CallOption) (*GetAssessmentTrendResponse, error) {
+	out := new(GetAssessmentTrendResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetAssessmentTrend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) GetSurvey(ctx context.Context, in *GetSurveyRequest, opts ...grpc.CallOption) (*GetSurveyResponse, error) {
+	out := new(GetSurveyResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/GetSurvey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) SubmitSurveyResponse(ctx context.Context, in *SubmitSurveyResponseRequest, opts ...grpc.CallOption) (*SubmitSurveyResponseResponse, error) {
+	out := new(SubmitSurveyResponseResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/SubmitSurveyResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthTrackingClient) ListSurveyResponses(ctx context.Context, in *ListSurveyResponsesRequest, opts ...grpc.CallOption) (*ListSurveyResponsesResponse, error) {
+	out := new(ListSurveyResponsesResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthTracking/ListSurveyResponses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthTrackingServer is the server API for HealthTracking service.
 // All implementations must embed UnimplementedHealthTrackingServer
 // for forward compatibility
@@ -102,6 +449,7 @@ type HealthTrackingServer interface {
 	//Health data requested to be added by user is added, and error is returned if appropriate.
 	AddHealthDataForUser(context.Context, *AddHealthDataForUserRequest) (*AddHealthDataForUserResponse, error)
 	//Health data requested by user to be deleted is deleted and said deleted entries are returned to user.
+	//Deleted entries are kept in the trash until they are purged.
 	DeleteHealthDataForUser(context.Context, *DeleteHealthDataForUserRequest) (*DeleteHealthDataForUserResponse, error)
 	//Health data requested to be updated by user is updated, and error is returned if appropriate.
 	UpdateHealthDataForDate(context.Context, *UpdateHealthDataForDateRequest) (*UpdateHealthDataForDateResponse, error)
@@ -109,6 +457,61 @@ type HealthTrackingServer interface {
 	GetMentalHealthScoreForUser(context.Context, *GetMentalHealthScoreForUserRequest) (*GetMentalHealthScoreForUserResponse, error)
 	// Given a date and user ID, return health data log for a specific date
 	GetHealthDataByDate(context.Context, *GetHealthDataByDateRequest) (*GetHealthDataByDateResponse, error)
+	// Given a date range and user ID, return one page of health data logs within the range
+	GetHealthDataInRange(context.Context, *GetHealthDataInRangeRequest) (*GetHealthDataInRangeResponse, error)
+	// Given a date range and user ID, return daily, weekly and monthly score statistics and rolling averages
+	GetMoodTrends(context.Context, *GetMoodTrendsRequest) (*GetMoodTrendsResponse, error)
+	// Given a log entry ID and user ID, return that log entry
+	GetHealthLogByID(context.Context, *GetHealthLogByIDRequest) (*GetHealthLogByIDResponse, error)
+	// Given a log entry ID and user ID, update that log entry only
+	UpdateHealthLogByID(context.Context, *UpdateHealthLogByIDRequest) (*UpdateHealthLogByIDResponse, error)
+	// Given a log entry ID and user ID, delete that log entry only
+	DeleteHealthLogByID(context.Context, *DeleteHealthLogByIDRequest) (*DeleteHealthLogByIDResponse, error)
+	// Given user ID, return the deleted log entries that can still be restored
+	ListDeletedHealthLogs(context.Context, *ListDeletedHealthLogsRequest) (*ListDeletedHealthLogsResponse, error)
+	// Given log entry IDs and user ID, move those entries out of the trash
+	RestoreHealthLogs(context.Context, *RestoreHealthLogsRequest) (*RestoreHealthLogsResponse, error)
+	// Given a log entry ID and user ID, return how that entry looked before each of its updates
+	GetHealthLogRevisions(context.Context, *GetHealthLogRevisionsRequest) (*GetHealthLogRevisionsResponse, error)
+	// Given a log entry ID, revision and user ID, set that entry back to the revision, keeping its current state as a new revision
+	RevertHealthLogToRevision(context.Context, *RevertHealthLogToRevisionRequest) (*RevertHealthLogToRevisionResponse, error)
+	// Given user ID, share their health data with another user until the grant expires
+	GrantAccess(context.Context, *GrantAccessRequest) (*GrantAccessResponse, error)
+	// Given user ID, stop sharing their health data with another user
+	RevokeAccess(context.Context, *RevokeAccessRequest) (*RevokeAccessResponse, error)
+	// Given user ID, return the access grants they have given and received
+	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
+	// Given user ID, stream a copy of all of their health data, starting with a manifest of what it holds
+	ExportUserData(*ExportUserDataRequest, HealthTracking_ExportUserDataServer) error
+	// Return the mood dimensions logs can rate, with their ranges
+	ListMoodDimensions(context.Context, *ListMoodDimensionsRequest) (*ListMoodDimensionsResponse, error)
+	// Given user ID, return the tags on their logs with how often each is used
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Given user ID, rename a tag on all of their logs
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// Given user ID, replace several tags with one on all of their logs
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// Given a date range and user ID, return the average score on days with and without each tag
+	GetTagImpact(context.Context, *GetTagImpactRequest) (*GetTagImpactResponse, error)
+	// Given user ID, add batches of historical health data, skipping entries for dates that already have one
+	ImportHealthData(HealthTracking_ImportHealthDataServer) error
+	// Given user ID, add the health data of a file exported from another mood tracking app, skipping entries for dates
+	// that already have one
+	ImportExternalHealthData(context.Context, *ImportExternalHealthDataRequest) (*ImportHealthDataResponse, error)
+	// Given user ID, return their health data as FHIR Observations, for use by healthcare providers
+	GetHealthDataAsFHIR(context.Context, *GetHealthDataAsFHIRRequest) (*GetHealthDataAsFHIRResponse, error)
+	// Given user ID, score and store their answers to a PHQ-9 or GAD-7 questionnaire
+	SubmitAssessment(context.Context, *SubmitAssessmentRequest) (*SubmitAssessmentResponse, error)
+	// Given user ID, return their assessments
+	ListAssessments(context.Context, *ListAssessmentsRequest) (*ListAssessmentsResponse, error)
+	// Given user ID, return how the total score of one questionnaire changed over time
+	GetAssessmentTrend(context.Context, *GetAssessmentTrendRequest) (*GetAssessmentTrendResponse, error)
+	// Return a published survey
+	GetSurvey(context.Context, *GetSurveyRequest) (*GetSurveyResponse, error)
+	// Given user ID, check their answers to a survey against its branching, score them and store them
+	SubmitSurveyResponse(context.Context, *SubmitSurveyResponseRequest) (*SubmitSurveyResponseResponse, error)
+	// Given user ID, return their survey responses
+	ListSurveyResponses(context.Context, *ListSurveyResponsesRequest) (*ListSurveyResponsesResponse, error)
 	mustEmbedUnimplementedHealthTrackingServer()
 }
 
@@ -134,6 +537,87 @@ func (UnimplementedHealthTrackingServer) GetMentalHealthScoreForUser(context.Con
 func (UnimplementedHealthTrackingServer) GetHealthDataByDate(context.Context, *GetHealthDataByDateRequest) (*GetHealthDataByDateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealthDataByDate not implemented")
 }
+func (UnimplementedHealthTrackingServer) GetHealthDataInRange(context.Context, *GetHealthDataInRangeRequest) (*GetHealthDataInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthDataInRange not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetMoodTrends(context.Context, *GetMoodTrendsRequest) (*GetMoodTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoodTrends not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetHealthLogByID(context.Context, *GetHealthLogByIDRequest) (*GetHealthLogByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthLogByID not implemented")
+}
+func (UnimplementedHealthTrackingServer) UpdateHealthLogByID(context.Context, *UpdateHealthLogByIDRequest) (*UpdateHealthLogByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHealthLogByID not implemented")
+}
+func (UnimplementedHealthTrackingServer) DeleteHealthLogByID(context.Context, *DeleteHealthLogByIDRequest) (*DeleteHealthLogByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHealthLogByID not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListDeletedHealthLogs(context.Context, *ListDeletedHealthLogsRequest) (*ListDeletedHealthLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedHealthLogs not implemented")
+}
+func (UnimplementedHealthTrackingServer) RestoreHealthLogs(context.Context, *RestoreHealthLogsRequest) (*RestoreHealthLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreHealthLogs not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetHealthLogRevisions(context.Context, *GetHealthLogRevisionsRequest) (*GetHealthLogRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthLogRevisions not implemented")
+}
+func (UnimplementedHealthTrackingServer) RevertHealthLogToRevision(context.Context, *RevertHealthLogToRevisionRequest) (*RevertHealthLogToRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertHealthLogToRevision not implemented")
+}
+func (UnimplementedHealthTrackingServer) GrantAccess(context.Context, *GrantAccessRequest) (*GrantAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccess not implemented")
+}
+func (UnimplementedHealthTrackingServer) RevokeAccess(context.Context, *RevokeAccessRequest) (*RevokeAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccess not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (UnimplementedHealthTrackingServer) ExportUserData(*ExportUserDataRequest, HealthTracking_ExportUserDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListMoodDimensions(context.Context, *ListMoodDimensionsRequest) (*ListMoodDimensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMoodDimensions not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedHealthTrackingServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedHealthTrackingServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetTagImpact(context.Context, *GetTagImpactRequest) (*GetTagImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagImpact not implemented")
+}
+func (UnimplementedHealthTrackingServer) ImportHealthData(HealthTracking_ImportHealthDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportHealthData not implemented")
+}
+func (UnimplementedHealthTrackingServer) ImportExternalHealthData(context.Context, *ImportExternalHealthDataRequest) (*ImportHealthDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExternalHealthData not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetHealthDataAsFHIR(context.Context, *GetHealthDataAsFHIRRequest) (*GetHealthDataAsFHIRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthDataAsFHIR not implemented")
+}
+func (UnimplementedHealthTrackingServer) SubmitAssessment(context.Context, *SubmitAssessmentRequest) (*SubmitAssessmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAssessment not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListAssessments(context.Context, *ListAssessmentsRequest) (*ListAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssessments not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetAssessmentTrend(context.Context, *GetAssessmentTrendRequest) (*GetAssessmentTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentTrend not implemented")
+}
+func (UnimplementedHealthTrackingServer) GetSurvey(context.Context, *GetSurveyRequest) (*GetSurveyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurvey not implemented")
+}
+func (UnimplementedHealthTrackingServer) SubmitSurveyResponse(context.Context, *SubmitSurveyResponseRequest) (*SubmitSurveyResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSurveyResponse not implemented")
+}
+func (UnimplementedHealthTrackingServer) ListSurveyResponses(context.Context, *ListSurveyResponsesRequest) (*ListSurveyResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSurveyResponses not implemented")
+}
 func (UnimplementedHealthTrackingServer) mustEmbedUnimplementedHealthTrackingServer() {}
 
 // UnsafeHealthTrackingServer may be embedded to opt out of forward compatibility for this service.
@@ -255,33 +739,878 @@ func _HealthTracking_GetHealthDataByDate_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-var _HealthTracking_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kic.health.HealthTracking",
-	HandlerType: (*HealthTrackingServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetHealthDataForUser",
-			Handler:    _HealthTracking_GetHealthDataForUser_Handler,
-		},
-		{
-			MethodName: "AddHealthDataForUser",
-			Handler:    _HealthTracking_AddHealthDataForUser_Handler,
-		},
-		{
-			MethodName: "DeleteHealthDataForUser",
-			Handler:    _HealthTracking_DeleteHealthDataForUser_Handler,
-		},
-		{
-			MethodName: "UpdateHealthDataForDate",
-			Handler:    _HealthTracking_UpdateHealthDataForDate_Handler,
-		},
-		{
-			MethodName: "GetMentalHealthScoreForUser",
-			Handler:    _HealthTracking_GetMentalHealthScoreForUser_Handler,
-		},
-		{
-			MethodName: "GetHealthDataByDate",
-			Handler:    _HealthTracking_GetHealthDataByDate_Handler,
+func _HealthTracking_GetHealthDataInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthDataInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetHealthDataInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetHealthDataInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetHealthDataInRange(ctx, req.(*GetHealthDataInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetMoodTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMoodTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetMoodTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetMoodTrends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetMoodTrends(ctx, req.(*GetMoodTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetHealthLogByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthLogByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetHealthLogByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetHealthLogByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetHealthLogByID(ctx, req.(*GetHealthLogByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_UpdateHealthLogByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHealthLogByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).UpdateHealthLogByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/UpdateHealthLogByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).UpdateHealthLogByID(ctx, req.(*UpdateHealthLogByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_DeleteHealthLogByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHealthLogByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).DeleteHealthLogByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/DeleteHealthLogByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).DeleteHealthLogByID(ctx, req.(*DeleteHealthLogByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ListDeletedHealthLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedHealthLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListDeletedHealthLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListDeletedHealthLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListDeletedHealthLogs(ctx, req.(*ListDeletedHealthLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_RestoreHealthLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreHealthLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).RestoreHealthLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/RestoreHealthLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).RestoreHealthLogs(ctx, req.(*RestoreHealthLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetHealthLogRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthLogRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetHealthLogRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetHealthLogRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetHealthLogRevisions(ctx, req.(*GetHealthLogRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_RevertHealthLogToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertHealthLogToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).RevertHealthLogToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/RevertHealthLogToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).RevertHealthLogToRevision(ctx, req.(*RevertHealthLogToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GrantAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GrantAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GrantAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GrantAccess(ctx, req.(*GrantAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_RevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).RevokeAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/RevokeAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).RevokeAccess(ctx, req.(*RevokeAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListGrants(ctx, req.(*ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HealthTrackingServer).ExportUserData(m, &healthTrackingExportUserDataServer{stream})
+}
+
+type HealthTracking_ExportUserDataServer interface {
+	Send(*ExportUserDataResponse) error
+	grpc.ServerStream
+}
+
+type healthTrackingExportUserDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthTrackingExportUserDataServer) Send(m *ExportUserDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HealthTracking_ListMoodDimensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMoodDimensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListMoodDimensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListMoodDimensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListMoodDimensions(ctx, req.(*ListMoodDimensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/RenameTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/MergeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetTagImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetTagImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetTagImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetTagImpact(ctx, req.(*GetTagImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ImportHealthData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HealthTrackingServer).ImportHealthData(&healthTrackingImportHealthDataServer{stream})
+}
+
+type HealthTracking_ImportHealthDataServer interface {
+	SendAndClose(*ImportHealthDataResponse) error
+	Recv() (*ImportHealthDataRequest, error)
+	grpc.ServerStream
+}
+
+type healthTrackingImportHealthDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthTrackingImportHealthDataServer) SendAndClose(m *ImportHealthDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *healthTrackingImportHealthDataServer) Recv() (*ImportHealthDataRequest, error) {
+	m := new(ImportHealthDataRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _HealthTracking_ImportExternalHealthData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExternalHealthDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ImportExternalHealthData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ImportExternalHealthData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ImportExternalHealthData(ctx, req.(*ImportExternalHealthDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetHealthDataAsFHIR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthDataAsFHIRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetHealthDataAsFHIR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetHealthDataAsFHIR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetHealthDataAsFHIR(ctx, req.(*GetHealthDataAsFHIRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_SubmitAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAssessmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).SubmitAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/SubmitAssessment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).SubmitAssessment(ctx, req.(*SubmitAssessmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ListAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListAssessments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListAssessments(ctx, req.(*ListAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetAssessmentTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetAssessmentTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetAssessmentTrend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetAssessmentTrend(ctx, req.(*GetAssessmentTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_GetSurvey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSurveyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).GetSurvey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/GetSurvey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).GetSurvey(ctx, req.(*GetSurveyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_SubmitSurveyResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSurveyResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).SubmitSurveyResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/SubmitSurveyResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).SubmitSurveyResponse(ctx, req.(*SubmitSurveyResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthTracking_ListSurveyResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSurveyResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthTrackingServer).ListSurveyResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthTracking/ListSurveyResponses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthTrackingServer).ListSurveyResponses(ctx, req.(*ListSurveyResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HealthTracking_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kic.health.HealthTracking",
+	HandlerType: (*HealthTrackingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHealthDataForUser",
+			Handler:    _HealthTracking_GetHealthDataForUser_Handler,
+		},
+		{
+			MethodName: "AddHealthDataForUser",
+			Handler:    _HealthTracking_AddHealthDataForUser_Handler,
+		},
+		{
+			MethodName: "DeleteHealthDataForUser",
+			Handler:    _HealthTracking_DeleteHealthDataForUser_Handler,
+		},
+		{
+			MethodName: "UpdateHealthDataForDate",
+			Handler:    _HealthTracking_UpdateHealthDataForDate_Handler,
+		},
+		{
+			MethodName: "GetMentalHealthScoreForUser",
+			Handler:    _HealthTracking_GetMentalHealthScoreForUser_Handler,
+		},
+		{
+			MethodName: "GetHealthDataByDate",
+			Handler:    _HealthTracking_GetHealthDataByDate_Handler,
+		},
+		{
+			MethodName: "GetHealthDataInRange",
+			Handler:    _HealthTracking_GetHealthDataInRange_Handler,
+		},
+		{
+			MethodName: "GetMoodTrends",
+			Handler:    _HealthTracking_GetMoodTrends_Handler,
+		},
+		{
+			MethodName: "GetHealthLogByID",
+			Handler:    _HealthTracking_GetHealthLogByID_Handler,
+		},
+		{
+			MethodName: "UpdateHealthLogByID",
+			Handler:    _HealthTracking_UpdateHealthLogByID_Handler,
+		},
+		{
+			MethodName: "DeleteHealthLogByID",
+			Handler:    _HealthTracking_DeleteHealthLogByID_Handler,
+		},
+		{
+			MethodName: "ListDeletedHealthLogs",
+			Handler:    _HealthTracking_ListDeletedHealthLogs_Handler,
+		},
+		{
+			MethodName: "RestoreHealthLogs",
+			Handler:    _HealthTracking_RestoreHealthLogs_Handler,
+		},
+		{
+			MethodName: "GetHealthLogRevisions",
+			Handler:    _HealthTracking_GetHealthLogRevisions_Handler,
+		},
+		{
+			MethodName: "RevertHealthLogToRevision",
+			Handler:    _HealthTracking_RevertHealthLogToRevision_Handler,
+		},
+		{
+			MethodName: "GrantAccess",
+			Handler:    _HealthTracking_GrantAccess_Handler,
+		},
+		{
+			MethodName: "RevokeAccess",
+			Handler:    _HealthTracking_RevokeAccess_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _HealthTracking_ListGrants_Handler,
+		},
+		{
+			MethodName: "ListMoodDimensions",
+			Handler:    _HealthTracking_ListMoodDimensions_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _HealthTracking_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _HealthTracking_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _HealthTracking_MergeTags_Handler,
+		},
+		{
+			MethodName: "GetTagImpact",
+			Handler:    _HealthTracking_GetTagImpact_Handler,
+		},
+		{
+			MethodName: "ImportExternalHealthData",
+			Handler:    _HealthTracking_ImportExternalHealthData_Handler,
+		},
+		{
+			MethodName: "GetHealthDataAsFHIR",
+			Handler:    _HealthTracking_GetHealthDataAsFHIR_Handler,
+		},
+		{
+			MethodName: "SubmitAssessment",
+			Handler:    _HealthTracking_SubmitAssessment_Handler,
+		},
+		{
+			MethodName: "ListAssessments",
+			Handler:    _HealthTracking_ListAssessments_Handler,
+		},
+		{
+			MethodName: "GetAssessmentTrend",
+			Handler:    _HealthTracking_GetAssessmentTrend_Handler,
+		},
+		{
+			MethodName: "GetSurvey",
+			Handler:    _HealthTracking_GetSurvey_Handler,
+		},
+		{
+			MethodName: "SubmitSurveyResponse",
+			Handler:    _HealthTracking_SubmitSurveyResponse_Handler,
+		},
+		{
+			MethodName: "ListSurveyResponses",
+			Handler:    _HealthTracking_ListSurveyResponses_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _HealthTracking_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportHealthData",
+			Handler:       _HealthTracking_ImportHealthData_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/health.proto",
+}
+
+// HealthAdminClient is the client API for HealthAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HealthAdminClient interface {
+	// Recompute the running score totals used for mental health scores, for when they drift from the logs
+	RebuildScoreAggregates(ctx context.Context, in *RebuildScoreAggregatesRequest, opts ...grpc.CallOption) (*RebuildScoreAggregatesResponse, error)
+	// Fill in loggedAt and timeZone on mental health logs that only have a date
+	MigrateLogTimes(ctx context.Context, in *MigrateLogTimesRequest, opts ...grpc.CallOption) (*MigrateLogTimesResponse, error)
+	// Destroy a user's data key and permanently remove their health data
+	ShredUserData(ctx context.Context, in *ShredUserDataRequest, opts ...grpc.CallOption) (*ShredUserDataResponse, error)
+	// Search the record of who read or changed whose health data
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Check a survey definition and publish it as the next version of its survey
+	PublishSurvey(ctx context.Context, in *PublishSurveyRequest, opts ...grpc.CallOption) (*PublishSurveyResponse, error)
+}
+
+type healthAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthAdminClient(cc grpc.ClientConnInterface) HealthAdminClient {
+	return &healthAdminClient{cc}
+}
+
+func (c *healthAdminClient) RebuildScoreAggregates(ctx context.Context, in *RebuildScoreAggregatesRequest, opts ...grpc.CallOption) (*RebuildScoreAggregatesResponse, error) {
+	out := new(RebuildScoreAggregatesResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthAdmin/RebuildScoreAggregates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAdminClient) MigrateLogTimes(ctx context.Context, in *MigrateLogTimesRequest, opts ...grpc.CallOption) (*MigrateLogTimesResponse, error) {
+	out := new(MigrateLogTimesResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthAdmin/MigrateLogTimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAdminClient) ShredUserData(ctx context.Context, in *ShredUserDataRequest, opts ...grpc.CallOption) (*ShredUserDataResponse, error) {
+	out := new(ShredUserDataResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthAdmin/ShredUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAdminClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthAdmin/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAdminClient) PublishSurvey(ctx context.Context, in *PublishSurveyRequest, opts ...grpc.CallOption) (*PublishSurveyResponse, error) {
+	out := new(PublishSurveyResponse)
+	err := c.cc.Invoke(ctx, "/kic.health.HealthAdmin/PublishSurvey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthAdminServer is the server API for HealthAdmin service.
+// All implementations must embed UnimplementedHealthAdminServer
+// for forward compatibility
+type HealthAdminServer interface {
+	// Recompute the running score totals used for mental health scores, for when they drift from the logs
+	RebuildScoreAggregates(context.Context, *RebuildScoreAggregatesRequest) (*RebuildScoreAggregatesResponse, error)
+	// Fill in loggedAt and timeZone on mental health logs that only have a date
+	MigrateLogTimes(context.Context, *MigrateLogTimesRequest) (*MigrateLogTimesResponse, error)
+	// Destroy a user's data key and permanently remove their health data
+	ShredUserData(context.Context, *ShredUserDataRequest) (*ShredUserDataResponse, error)
+	// Search the record of who read or changed whose health data
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Check a survey definition and publish it as the next version of its survey
+	PublishSurvey(context.Context, *PublishSurveyRequest) (*PublishSurveyResponse, error)
+	mustEmbedUnimplementedHealthAdminServer()
+}
+
+// UnimplementedHealthAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedHealthAdminServer struct {
+}
+
+func (UnimplementedHealthAdminServer) RebuildScoreAggregates(context.Context, *RebuildScoreAggregatesRequest) (*RebuildScoreAggregatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildScoreAggregates not implemented")
+}
+func (UnimplementedHealthAdminServer) MigrateLogTimes(context.Context, *MigrateLogTimesRequest) (*MigrateLogTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateLogTimes not implemented")
+}
+func (UnimplementedHealthAdminServer) ShredUserData(context.Context, *ShredUserDataRequest) (*ShredUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShredUserData not implemented")
+}
+func (UnimplementedHealthAdminServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedHealthAdminServer) PublishSurvey(context.Context, *PublishSurveyRequest) (*PublishSurveyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSurvey not implemented")
+}
+func (UnimplementedHealthAdminServer) mustEmbedUnimplementedHealthAdminServer() {}
+
+// UnsafeHealthAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthAdminServer will
+// result in compilation errors.
+type UnsafeHealthAdminServer interface {
+	mustEmbedUnimplementedHealthAdminServer()
+}
+
+func RegisterHealthAdminServer(s grpc.ServiceRegistrar, srv HealthAdminServer) {
+	s.RegisterService(&_HealthAdmin_serviceDesc, srv)
+}
+
+func _HealthAdmin_RebuildScoreAggregates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildScoreAggregatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAdminServer).RebuildScoreAggregates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthAdmin/RebuildScoreAggregates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAdminServer).RebuildScoreAggregates(ctx, req.(*RebuildScoreAggregatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAdmin_MigrateLogTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateLogTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAdminServer).MigrateLogTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthAdmin/MigrateLogTimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAdminServer).MigrateLogTimes(ctx, req.(*MigrateLogTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAdmin_ShredUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShredUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAdminServer).ShredUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthAdmin/ShredUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAdminServer).ShredUserData(ctx, req.(*ShredUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAdmin_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAdminServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthAdmin/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAdminServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAdmin_PublishSurvey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSurveyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAdminServer).PublishSurvey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kic.health.HealthAdmin/PublishSurvey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAdminServer).PublishSurvey(ctx, req.(*PublishSurveyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HealthAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kic.health.HealthAdmin",
+	HandlerType: (*HealthAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RebuildScoreAggregates",
+			Handler:    _HealthAdmin_RebuildScoreAggregates_Handler,
+		},
+		{
+			MethodName: "MigrateLogTimes",
+			Handler:    _HealthAdmin_MigrateLogTimes_Handler,
+		},
+		{
+			MethodName: "ShredUserData",
+			Handler:    _HealthAdmin_ShredUserData_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _HealthAdmin_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "PublishSurvey",
+			Handler:    _HealthAdmin_PublishSurvey_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
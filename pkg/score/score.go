@@ -0,0 +1,17 @@
+package score
+
+import "fmt"
+
+// the range of mental health scores, from the worst to the best day
+const (
+	Min = -5
+	Max = 5
+)
+
+// Validate - check that a mental health score is within the range
+func Validate(score int32) error {
+	if score < Min || score > Max {
+		return fmt.Errorf("score must be between %v and %v", Min, Max)
+	}
+	return nil
+}
@@ -0,0 +1,469 @@
+package survey
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression - a parsed scoring formula or branching condition. Expressions are arithmetic over question IDs, whose
+// values are the number picked on a scale or the sum of the values of the choices picked, and 0 if they were not
+// answered. They support
+//
+//	arithmetic with + - * /, where dividing by 0 gives 0
+//	comparison with == != < <= > >=, giving 1 if it holds and 0 if not
+//	logic with && || !, where any value other than 0 holds
+//	sum, avg, min and max of any number of values
+//	answered(q), giving 1 if question q was answered
+//	has(q, "choice"), giving 1 if the choice was picked for question q
+type Expression struct {
+	source string
+	root   node
+}
+
+// Values - the answers an expression is evaluated against
+type Values interface {
+	Value(questionID string) float64
+	Answered(questionID string) bool
+	Has(questionID string, choiceID string) bool
+}
+
+// ParseExpression - the expression in source, or an error describing why it is not one
+func ParseExpression(source string) (*Expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if err = check(root, false); err != nil {
+		return nil, err
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// Identifiers - the question IDs the expression refers to
+func (e *Expression) Identifiers() []string {
+	seen := make(map[string]bool)
+	toReturn := make([]string, 0)
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *identNode:
+			if !seen[n.name] {
+				seen[n.name] = true
+				toReturn = append(toReturn, n.name)
+			}
+		case *unaryNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.left)
+			walk(n.right)
+		case *callNode:
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		}
+	}
+	walk(e.root)
+	return toReturn
+}
+
+// Evaluate - the value of the expression for the given answers
+func (e *Expression) Evaluate(values Values) float64 {
+	return evaluate(e.root, values)
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+type node interface{}
+
+type (
+	numberNode struct {
+		value float64
+	}
+	stringNode struct {
+		value string
+	}
+	identNode struct {
+		name string
+	}
+	unaryNode struct {
+		op string
+		x  node
+	}
+	binaryNode struct {
+		op    string
+		left  node
+		right node
+	}
+	callNode struct {
+		name string
+		args []node
+	}
+)
+
+type tokenKind int
+
+const (
+	numberToken tokenKind = iota
+	stringToken
+	identToken
+	operatorToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// operators, longest first so that "<=" is not read as "<" followed by "="
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "<", ">", "!", "(", ")", ","}
+
+func tokenize(source string) ([]token, error) {
+	tokens := make([]token, 0)
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{numberToken, string(runes[start:i])})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{identToken, string(runes[start:i])})
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, token{stringToken, string(runes[i+1 : end])})
+			i = end + 1
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, token{operatorToken, op})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected %q", string(r))
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+// parser - a recursive descent parser, one method per level of precedence from loosest to tightest
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peekOperator(ops ...string) (string, bool) {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != operatorToken {
+		return "", false
+	}
+	for _, op := range ops {
+		if p.tokens[p.pos].text == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) parseBinary(next func() (node, error), ops ...string) (node, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.peekOperator(ops...)
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseComparison, "&&")
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.peekOperator("==", "!=", "<", "<=", ">", ">=")
+	if !ok {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryNode{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if op, ok := p.peekOperator("-", "!"); ok {
+		p.pos++
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: op, x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+
+	switch t.kind {
+	case numberToken:
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.text)
+		}
+		return &numberNode{value: value}, nil
+	case stringToken:
+		return &stringNode{value: t.text}, nil
+	case identToken:
+		if _, ok := p.peekOperator("("); !ok {
+			return &identNode{name: t.text}, nil
+		}
+		p.pos++
+		args := make([]node, 0)
+		if _, ok := p.peekOperator(")"); ok {
+			p.pos++
+			return &callNode{name: t.text, args: args}, nil
+		}
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.peekOperator(","); ok {
+				p.pos++
+				continue
+			}
+			if _, ok := p.peekOperator(")"); ok {
+				p.pos++
+				return &callNode{name: t.text, args: args}, nil
+			}
+			return nil, fmt.Errorf("expected \",\" or \")\" in call to %v", t.text)
+		}
+	default:
+		if t.text == "(" {
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.peekOperator(")"); !ok {
+				return nil, fmt.Errorf("missing \")\"")
+			}
+			p.pos++
+			return x, nil
+		}
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+}
+
+// check - make sure functions exist and get the arguments they expect, and that strings are only used as choice IDs
+func check(n node, stringAllowed bool) error {
+	switch n := n.(type) {
+	case *stringNode:
+		if !stringAllowed {
+			return fmt.Errorf("unexpected string %q", n.value)
+		}
+	case *unaryNode:
+		return check(n.x, false)
+	case *binaryNode:
+		if err := check(n.left, false); err != nil {
+			return err
+		}
+		return check(n.right, false)
+	case *callNode:
+		switch n.name {
+		case "sum", "avg", "min", "max":
+			if len(n.args) == 0 {
+				return fmt.Errorf("%v needs at least one value", n.name)
+			}
+			for _, arg := range n.args {
+				if err := check(arg, false); err != nil {
+					return err
+				}
+			}
+		case "answered":
+			if _, ok := argIdent(n, 0); len(n.args) != 1 || !ok {
+				return fmt.Errorf("answered takes a question ID")
+			}
+		case "has":
+			_, identOK := argIdent(n, 0)
+			if len(n.args) != 2 || !identOK {
+				return fmt.Errorf("has takes a question ID and a choice ID")
+			}
+			if _, ok := n.args[1].(*stringNode); !ok {
+				return fmt.Errorf("has takes a question ID and a choice ID")
+			}
+		default:
+			return fmt.Errorf("unknown function %v", n.name)
+		}
+	}
+	return nil
+}
+
+func argIdent(n *callNode, i int) (string, bool) {
+	if i >= len(n.args) {
+		return "", false
+	}
+	ident, ok := n.args[i].(*identNode)
+	if !ok {
+		return "", false
+	}
+	return ident.name, true
+}
+
+func evaluate(n node, values Values) float64 {
+	switch n := n.(type) {
+	case *numberNode:
+		return n.value
+	case *identNode:
+		return values.Value(n.name)
+	case *unaryNode:
+		x := evaluate(n.x, values)
+		if n.op == "-" {
+			return -x
+		}
+		return boolValue(x == 0)
+	case *binaryNode:
+		left := evaluate(n.left, values)
+		// && and || only evaluate their right side when it decides the result
+		switch n.op {
+		case "&&":
+			return boolValue(left != 0 && evaluate(n.right, values) != 0)
+		case "||":
+			return boolValue(left != 0 || evaluate(n.right, values) != 0)
+		}
+		right := evaluate(n.right, values)
+		switch n.op {
+		case "+":
+			return left + right
+		case "-":
+			return left - right
+		case "*":
+			return left * right
+		case "/":
+			if right == 0 {
+				return 0
+			}
+			return left / right
+		case "==":
+			return boolValue(left == right)
+		case "!=":
+			return boolValue(left != right)
+		case "<":
+			return boolValue(left < right)
+		case "<=":
+			return boolValue(left <= right)
+		case ">":
+			return boolValue(left > right)
+		default:
+			return boolValue(left >= right)
+		}
+	case *callNode:
+		switch n.name {
+		case "answered":
+			questionID, _ := argIdent(n, 0)
+			return boolValue(values.Answered(questionID))
+		case "has":
+			questionID, _ := argIdent(n, 0)
+			return boolValue(values.Has(questionID, n.args[1].(*stringNode).value))
+		}
+
+		args := make([]float64, 0, len(n.args))
+		for _, arg := range n.args {
+			args = append(args, evaluate(arg, values))
+		}
+		toReturn := args[0]
+		switch n.name {
+		case "sum", "avg":
+			for _, arg := range args[1:] {
+				toReturn += arg
+			}
+			if n.name == "avg" {
+				toReturn /= float64(len(args))
+			}
+		case "min":
+			for _, arg := range args[1:] {
+				toReturn = math.Min(toReturn, arg)
+			}
+		case "max":
+			for _, arg := range args[1:] {
+				toReturn = math.Max(toReturn, arg)
+			}
+		}
+		return toReturn
+	}
+	return 0
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,227 @@
+package survey
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	pbhealth "github.com/kic/health/pkg/proto/health"
+)
+
+// limits on the size of surveys and their answers
+const (
+	MaxQuestions      = 200
+	MaxFreeTextLength = 5000
+)
+
+var (
+	surveyIDPattern   = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+	questionIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+// Validate - make sure a survey definition is complete, and that its conditions only refer to earlier questions and
+// its formulas to questions it has
+func Validate(survey *pbhealth.Survey) error {
+	if !surveyIDPattern.MatchString(survey.Id) {
+		return fmt.Errorf("survey ID %q must be lower case letters, digits, - and _", survey.Id)
+	}
+	if survey.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(survey.Questions) == 0 || len(survey.Questions) > MaxQuestions {
+		return fmt.Errorf("a survey has from 1 to %v questions", MaxQuestions)
+	}
+
+	questions := make(map[string]bool)
+	for _, question := range survey.Questions {
+		if err := validateQuestion(question, questions); err != nil {
+			return fmt.Errorf("question %q: %v", question.Id, err)
+		}
+		questions[question.Id] = true
+	}
+
+	names := make(map[string]bool)
+	for _, score := range survey.Scores {
+		if score.Name == "" || names[score.Name] {
+			return fmt.Errorf("score names must be set and unique, got %q", score.Name)
+		}
+		names[score.Name] = true
+
+		formula, err := ParseExpression(score.Formula)
+		if err != nil {
+			return fmt.Errorf("score %q: %v", score.Name, err)
+		}
+		for _, id := range formula.Identifiers() {
+			if !questions[id] {
+				return fmt.Errorf("score %q refers to unknown question %q", score.Name, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateQuestion - make sure a question is complete, given the IDs of the questions before it
+func validateQuestion(question *pbhealth.SurveyQuestion, earlier map[string]bool) error {
+	if !questionIDPattern.MatchString(question.Id) {
+		return fmt.Errorf("IDs must start with a letter and hold only letters, digits and _")
+	}
+	if earlier[question.Id] {
+		return fmt.Errorf("IDs must be unique")
+	}
+	if question.Text == "" {
+		return fmt.Errorf("text is required")
+	}
+
+	switch question.Type {
+	case pbhealth.QuestionType_SCALE:
+		if question.MinValue >= question.MaxValue {
+			return fmt.Errorf("minValue must be below maxValue")
+		}
+	case pbhealth.QuestionType_MULTIPLE_CHOICE:
+		if len(question.Choices) < 2 {
+			return fmt.Errorf("multiple choice questions need at least two choices")
+		}
+		choices := make(map[string]bool)
+		for _, choice := range question.Choices {
+			if choice.Id == "" || choices[choice.Id] {
+				return fmt.Errorf("choice IDs must be set and unique, got %q", choice.Id)
+			}
+			choices[choice.Id] = true
+		}
+	case pbhealth.QuestionType_FREE_TEXT:
+	default:
+		return fmt.Errorf("unknown question type %v", question.Type)
+	}
+
+	if question.ShowIf != "" {
+		condition, err := ParseExpression(question.ShowIf)
+		if err != nil {
+			return fmt.Errorf("showIf: %v", err)
+		}
+		for _, id := range condition.Identifiers() {
+			if !earlier[id] {
+				return fmt.Errorf("showIf can only refer to earlier questions, got %q", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// answers - the answers given so far, as the values expressions are evaluated against
+type answers struct {
+	values  map[string]float64
+	choices map[string]map[string]bool
+}
+
+func (a *answers) Value(questionID string) float64 {
+	return a.values[questionID]
+}
+
+func (a *answers) Answered(questionID string) bool {
+	_, ok := a.values[questionID]
+	return ok
+}
+
+func (a *answers) Has(questionID string, choiceID string) bool {
+	return a.choices[questionID][choiceID]
+}
+
+// Respond - check answers against a published survey, following its branching in question order, and compute its
+// scores. The answers are returned in question order.
+func Respond(survey *pbhealth.Survey, given []*pbhealth.SurveyAnswer) ([]*pbhealth.SurveyAnswer, []*pbhealth.SurveyScore, error) {
+	byQuestion := make(map[string]*pbhealth.SurveyAnswer, len(given))
+	for _, answer := range given {
+		if _, ok := byQuestion[answer.QuestionID]; ok {
+			return nil, nil, fmt.Errorf("question %q is answered more than once", answer.QuestionID)
+		}
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	a := &answers{values: make(map[string]float64), choices: make(map[string]map[string]bool)}
+	ordered := make([]*pbhealth.SurveyAnswer, 0, len(given))
+
+	for _, question := range survey.Questions {
+		answer, answered := byQuestion[question.Id]
+		delete(byQuestion, question.Id)
+
+		if question.ShowIf != "" {
+			condition, err := ParseExpression(question.ShowIf)
+			if err != nil {
+				return nil, nil, fmt.Errorf("question %q: showIf: %v", question.Id, err)
+			}
+			if condition.Evaluate(a) == 0 {
+				if answered {
+					return nil, nil, fmt.Errorf("question %q is not asked for these answers", question.Id)
+				}
+				continue
+			}
+		}
+
+		if !answered {
+			if question.Required {
+				return nil, nil, fmt.Errorf("question %q is required", question.Id)
+			}
+			continue
+		}
+
+		value, choices, err := answerValue(question, answer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("question %q: %v", question.Id, err)
+		}
+		a.values[question.Id] = value
+		a.choices[question.Id] = choices
+		ordered = append(ordered, answer)
+	}
+
+	for questionID := range byQuestion {
+		return nil, nil, fmt.Errorf("survey has no question %q", questionID)
+	}
+
+	scores := make([]*pbhealth.SurveyScore, 0, len(survey.Scores))
+	for _, score := range survey.Scores {
+		formula, err := ParseExpression(score.Formula)
+		if err != nil {
+			return nil, nil, fmt.Errorf("score %q: %v", score.Name, err)
+		}
+		scores = append(scores, &pbhealth.SurveyScore{Name: score.Name, Value: formula.Evaluate(a)})
+	}
+
+	return ordered, scores, nil
+}
+
+// answerValue - check an answer against its question, returning its value in expressions and the choices picked
+func answerValue(question *pbhealth.SurveyQuestion, answer *pbhealth.SurveyAnswer) (float64, map[string]bool, error) {
+	switch question.Type {
+	case pbhealth.QuestionType_SCALE:
+		if answer.Value < question.MinValue || answer.Value > question.MaxValue {
+			return 0, nil, fmt.Errorf("value must be from %v to %v", question.MinValue, question.MaxValue)
+		}
+		return float64(answer.Value), nil, nil
+	case pbhealth.QuestionType_MULTIPLE_CHOICE:
+		if len(answer.ChoiceIDs) == 0 || (!question.AllowMultiple && len(answer.ChoiceIDs) > 1) {
+			return 0, nil, fmt.Errorf("pick one choice, or more if the question allows it")
+		}
+		choiceValues := make(map[string]float64, len(question.Choices))
+		for _, choice := range question.Choices {
+			choiceValues[choice.Id] = choice.Value
+		}
+		var value float64
+		picked := make(map[string]bool, len(answer.ChoiceIDs))
+		for _, choiceID := range answer.ChoiceIDs {
+			choiceValue, ok := choiceValues[choiceID]
+			if !ok || picked[choiceID] {
+				return 0, nil, fmt.Errorf("choice %q is unknown or picked twice", choiceID)
+			}
+			picked[choiceID] = true
+			value += choiceValue
+		}
+		return value, picked, nil
+	default:
+		if utf8.RuneCountInString(answer.Text) > MaxFreeTextLength {
+			return 0, nil, fmt.Errorf("answers can be at most %v characters", MaxFreeTextLength)
+		}
+		return 0, nil, nil
+	}
+}
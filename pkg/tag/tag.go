@@ -0,0 +1,69 @@
+package tag
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTags - the most tags a log can carry
+	MaxTags = 20
+	// MaxTagLength - the longest tag name, in characters
+	MaxTagLength = 50
+)
+
+// ValidateName - check that a tag name is non-empty, short enough and has no surrounding whitespace
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tags must not be empty")
+	}
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("tag %q must not start or end with whitespace", name)
+	}
+	if utf8.RuneCountInString(name) > MaxTagLength {
+		return fmt.Errorf("tags must be at most %v characters", MaxTagLength)
+	}
+	return nil
+}
+
+// Validate - check the tags of a log, each must be a valid name and appear once
+func Validate(tags []string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("a log can have at most %v tags", MaxTags)
+	}
+
+	seen := make(map[string]bool, len(tags))
+	for _, name := range tags {
+		if err := ValidateName(name); err != nil {
+			return err
+		}
+		if seen[name] {
+			return fmt.Errorf("tag %q appears more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Replace - tags with every name in sources replaced by target, keeping the order and dropping duplicates, and
+// whether anything changed
+func Replace(tags []string, sources []string, target string) ([]string, bool) {
+	replaced := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	changed := false
+	for _, name := range tags {
+		for _, source := range sources {
+			if name == source {
+				name = target
+				changed = true
+				break
+			}
+		}
+		if !seen[name] {
+			seen[name] = true
+			replaced = append(replaced, name)
+		}
+	}
+	return replaced, changed
+}